@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	hexhunter "github.com/Amr-9/HexHunter"
+	"github.com/Amr-9/HexHunter/internal/ui"
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// Exit codes of a non-interactive search (flag uses 2 for invalid flags)
+const (
+	exitFound    = 0
+	exitFailed   = 1 // Invalid settings, an engine error or Ctrl+C
+	exitNotFound = 3 // A limit was reached without a match
+	exitUsage    = 3 // A flag value that does not fit the network, e.g. a -type it does not have
+)
+
+// usageError is a flag value the selected network rejects; runBatch exits with exitUsage.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// Command-line flags. With -network the search runs without the menus;
+// the limits also apply to interactive searches.
+var (
+	networkFlag  = flag.String("network", "", "search `name` without the menus (e.g. ethereum, solana, nostr)")
+	typeFlag     = flag.String("type", "", "address type of the network (e.g. taproot, p2pkh, enterprise, \"wallet v5r1\")")
+	prefixFlag   = flag.String("prefix", "", "address prefix, after the network's fixed characters (0x, npub1...)")
+	suffixFlag   = flag.String("suffix", "", "address suffix")
+	containsFlag = flag.String("contains", "", "pattern anywhere in the address")
+	engineFlag   = flag.String("engine", "auto", "search engine: auto, cpu or gpu")
+	jsonFlag     = flag.Bool("json", false, "with -network, print progress and the outcome as JSON lines instead of the display")
+
+	maxAttemptsFlag = flag.Uint64("max-attempts", 0, "give up after `n` attempts (0 = no limit)")
+	maxDurationFlag = flag.Duration("max-duration", 0, "give up after searching this long, e.g. 10m (0 = no limit)")
+	deadlineFlag    time.Time
+	optionFlags     = map[string]string{}
+)
+
+func init() {
+	flag.Func("deadline", "give up at this `time` (RFC 3339, e.g. 2026-01-02T15:04:05Z)", func(value string) error {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("not an RFC 3339 time")
+		}
+		deadlineFlag = t
+		return nil
+	})
+	flag.Func("option", "network setting as `name=value`, repeatable (e.g. ss58=2)", func(value string) error {
+		name, val, ok := strings.Cut(value, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("want name=value")
+		}
+		optionFlags[name] = val
+		return nil
+	})
+}
+
+// jsonInterval is how often -json prints the progress
+const jsonInterval = time.Second
+
+// jsonRecord is one line of the -json output: the event and the statistics of the search
+// at that point, with the expected time until the probability of a match reaches
+// 50%, 90% and 99% (null while the hash rate is not measured yet).
+type jsonRecord struct {
+	Event          string   `json:"event"` // progress, match, not found, error or done (see generator.EventType)
+	Attempts       uint64   `json:"attempts"`
+	ElapsedSecs    float64  `json:"elapsed_secs"`
+	HashRate       float64  `json:"hash_rate"`        // Average since the start
+	RecentHashRate float64  `json:"recent_hash_rate"` // Smoothed over the last seconds
+	Difficulty     uint64   `json:"difficulty"`
+	Probability    float64  `json:"probability"`
+	ETA50Secs      *float64 `json:"eta50_secs"`
+	ETA90Secs      *float64 `json:"eta90_secs"`
+	ETA99Secs      *float64 `json:"eta99_secs"`
+	Address        string   `json:"address,omitempty"` // Found address, for match; the keys are saved like in the display mode
+	Error          string   `json:"error,omitempty"`   // Reached limit, for not found; the failure, for error
+}
+
+// printJSON prints an event of the search as a line of JSON.
+func printJSON(event generator.EventType, stats generator.Stats, address string, err error) {
+	eta := func(p float64) *float64 {
+		d, ok := stats.TimeTo(p)
+		if !ok {
+			return nil
+		}
+		secs := d.Seconds()
+		return &secs
+	}
+	record := jsonRecord{
+		Event:          event.String(),
+		Attempts:       stats.Attempts,
+		ElapsedSecs:    stats.ElapsedSecs,
+		HashRate:       stats.HashRate,
+		RecentHashRate: stats.RecentHashRate,
+		Difficulty:     stats.Difficulty,
+		Probability:    stats.Probability,
+		ETA50Secs:      eta(0.5),
+		ETA90Secs:      eta(0.9),
+		ETA99Secs:      eta(0.99),
+		Address:        address,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	json.NewEncoder(os.Stdout).Encode(record)
+}
+
+// applyLimits sets the search limits given on the command line.
+func applyLimits(config *generator.Config) {
+	config.MaxAttempts = *maxAttemptsFlag
+	config.MaxDuration = *maxDurationFlag
+	config.Deadline = deadlineFlag
+}
+
+// runBatch runs one search from the command-line flags, without prompts,
+// and returns the exit code: a match is saved like in the interactive mode;
+// a reached limit prints the final statistics and exits with exitNotFound.
+// With -json, the progress and the outcome are printed as JSON lines (see jsonRecord).
+func runBatch() int {
+	if *jsonFlag {
+		status = os.Stderr
+	}
+
+	config, engine, err := batchConfig()
+	if usageErr := (*usageError)(nil); errors.As(err, &usageErr) {
+		fmt.Fprintf(os.Stderr, "hexhunter: %v\nRun hexhunter -h for the flags.\n", err)
+		return exitUsage
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hexhunter: %v\n", err)
+		return exitFailed
+	}
+
+	searcher, err := hexhunter.NewSearcher(hexhunter.Options{Config: config, Engine: engine})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hexhunter: %v\n", err)
+		return exitFailed
+	}
+	defer searcher.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if !*jsonFlag {
+		fmt.Printf("\n    %s⚙ %s engine%s\n", ui.ColorDim, searcher.Name(), ui.ColorReset)
+		ui.PrintSearchInfo(&config, searcher.Difficulty())
+		fmt.Println()
+	}
+
+	results, err := searcher.Run(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hexhunter: %v\n", err)
+		return exitFailed
+	}
+
+	// Progress is drawn while the iteration blocks
+	progressDone := make(chan struct{})
+	progressStopped := make(chan struct{})
+	go func() {
+		defer close(progressStopped)
+		interval := updateRate
+		if *jsonFlag {
+			interval = jsonInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for frame := 0; ; frame++ {
+			select {
+			case <-progressDone:
+				return
+			case <-ticker.C:
+				if *jsonFlag {
+					printJSON(generator.EventProgress, searcher.Stats(), "", nil)
+				} else {
+					ui.PrintProgress(searcher.Stats(), frame)
+				}
+			}
+		}
+	}()
+
+	var found *generator.Result
+	for result := range results {
+		found = &result
+	}
+	close(progressDone)
+	<-progressStopped
+
+	stats := searcher.Stats()
+	if *jsonFlag {
+		return finishJSON(found, stats, searcher.Err())
+	}
+	ui.ClearLine()
+	elapsed := time.Duration(stats.ElapsedSecs * float64(time.Second))
+	switch err := searcher.Err(); {
+	case found != nil:
+		ui.PrintSuccess(*found, elapsed, stats.Attempts, outputFile)
+		dir := keyFilesDir(*found)
+		saveResult(*found, elapsed, stats.Attempts, dir)
+		saveKeyFiles(*found, dir)
+		return exitFound
+	case errors.Is(err, generator.ErrNotFound):
+		ui.PrintNotFound(err, stats)
+		return exitNotFound
+	case err != nil:
+		ui.PrintSearchError(err, elapsed, stats.Attempts)
+		return exitFailed
+	default:
+		ui.PrintSearchEnded(elapsed, stats.Attempts)
+		return exitFailed
+	}
+}
+
+// finishJSON prints the outcome of a -json search, saves a match and returns the exit code.
+func finishJSON(found *generator.Result, stats generator.Stats, err error) int {
+	switch {
+	case found != nil:
+		elapsed := time.Duration(stats.ElapsedSecs * float64(time.Second))
+		dir := keyFilesDir(*found)
+		saveResult(*found, elapsed, stats.Attempts, dir)
+		saveKeyFiles(*found, dir)
+		printJSON(generator.EventMatch, stats, found.Address, nil)
+		return exitFound
+	case errors.Is(err, generator.ErrNotFound):
+		printJSON(generator.EventNotFound, stats, "", err)
+		return exitNotFound
+	case err != nil:
+		printJSON(generator.EventError, stats, "", err)
+		return exitFailed
+	default:
+		printJSON(generator.EventDone, stats, "", nil)
+		return exitFailed
+	}
+}
+
+// batchConfig builds the search configuration and engine from the flags.
+func batchConfig() (generator.Config, hexhunter.Engine, error) {
+	info, ok := lookupNetwork(*networkFlag)
+	if !ok {
+		return generator.Config{}, 0, fmt.Errorf("unknown network %q", *networkFlag)
+	}
+
+	config := generator.Config{
+		Network:  info.Network,
+		Prefix:   *prefixFlag,
+		Suffix:   *suffixFlag,
+		Contains: *containsFlag,
+		Options:  optionFlags,
+	}
+	applyLimits(&config)
+	if *typeFlag != "" {
+		addressType, err := parseAddressType(info, *typeFlag)
+		if err != nil {
+			return generator.Config{}, 0, err
+		}
+		config.AddressType = addressType
+	}
+
+	var engine hexhunter.Engine
+	switch strings.ToLower(*engineFlag) {
+	case "auto":
+		engine = hexhunter.EngineAuto
+	case "cpu":
+		engine = hexhunter.EngineCPU
+	case "gpu":
+		engine = hexhunter.EngineGPU
+	default:
+		return generator.Config{}, 0, fmt.Errorf("unknown engine %q (want auto, cpu or gpu)", *engineFlag)
+	}
+	return config, engine, nil
+}
+
+// lookupNetwork finds a registered network by name, ignoring case and punctuation
+// (e.g. "didkey" for did:key).
+func lookupNetwork(name string) (generator.NetworkInfo, bool) {
+	for _, info := range generator.Networks() {
+		if normalizeName(info.Name) == normalizeName(name) {
+			return info, true
+		}
+	}
+	return generator.NetworkInfo{}, false
+}
+
+// parseAddressType finds one of the address types of a network by its menu name,
+// e.g. "Taproot (P2TR)", by the name alone ("taproot") or by the text in parentheses ("p2tr").
+// Other names are a usageError listing the types of the network.
+func parseAddressType(info generator.NetworkInfo, name string) (generator.AddressType, error) {
+	want := normalizeName(name)
+	names := make([]string, 0, len(info.AddressTypes))
+	for _, t := range info.AddressTypes {
+		full := t.String()
+		short, paren, _ := strings.Cut(full, " (")
+		if want == normalizeName(full) || want == normalizeName(short) || want == normalizeName(strings.TrimSuffix(paren, ")")) {
+			return t, nil
+		}
+		names = append(names, fmt.Sprintf("%q", short))
+	}
+	if len(names) == 0 {
+		return generator.AddressTypeDefault, &usageError{fmt.Sprintf("%s has no address types, -type %q does not apply", info.Name, name)}
+	}
+	return generator.AddressTypeDefault, &usageError{fmt.Sprintf("%s has no address type %q (want %s)", info.Name, name, strings.Join(names, ", "))}
+}
+
+// normalizeName lowercases a name and drops everything but letters and digits.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return -1
+		}
+	}, name)
+}
@@ -2,15 +2,20 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/Amr-9/HexHunter/internal/ui"
 	"github.com/Amr-9/HexHunter/pkg/generator"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/networks" // Register all built-in networks
 )
 
 const (
@@ -22,7 +27,16 @@ const (
 // Global state for current network
 var currentNetwork generator.Network = generator.Ethereum
 
+// status receives the reports of saving a result; stderr with -json, so stdout stays JSON
+var status io.Writer = os.Stdout
+
 func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if *networkFlag != "" {
+		os.Exit(runBatch())
+	}
+
 	// Clear screen and show banner
 	ui.ClearScreen()
 	ui.PrintWelcomeBanner(version)
@@ -33,11 +47,15 @@ func main() {
 		os.Exit(0)
 	}
 	currentNetwork = network
+	// gen is replaced on a network switch, so the deferred call closes the last one
+	defer func() { gen.Close() }()
 
 	// Main application loop
 	for {
 		// Interactive prompts for prefix/suffix/contains
-		prefix, suffix, contains := ui.GetInputFromUser(currentNetwork)
+		config := ui.NewConfig(currentNetwork)
+		applyLimits(config)
+		prefix, suffix, contains := ui.GetInputFromUser(config)
 
 		// Validate at least one is provided
 		if prefix == "" && suffix == "" && contains == "" {
@@ -45,15 +63,11 @@ func main() {
 			continue
 		}
 
-		// Create configuration
-		config := &generator.Config{
-			Network:     currentNetwork,
-			AddressType: ui.SelectedBitcoinAddressType, // Used by Bitcoin
-			Prefix:      prefix,
-			Suffix:      suffix,
-			Contains:    contains,
-			Workers:     runtime.NumCPU(),
-		}
+		// Complete configuration
+		config.Prefix = prefix
+		config.Suffix = suffix
+		config.Contains = contains
+		config.Workers = runtime.NumCPU()
 
 		// Setup context with cancellation
 		ctx, cancel := context.WithCancel(context.Background())
@@ -63,11 +77,12 @@ func main() {
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 		// Print search info
-		difficulty := estimateDifficulty(config.Prefix, config.Suffix, config.Contains, currentNetwork)
+		difficulty := generator.EstimateDifficulty(config)
 		ui.PrintSearchInfo(config, difficulty)
+		ui.PrintSearchKeys()
 
 		// Start the generator
-		resultChan, err := gen.Start(ctx, config)
+		events, err := gen.Start(ctx, config)
 		if err != nil {
 			fmt.Printf("\n    %s✗ Error: %v%s\n", ui.ColorRed, err, ui.ColorReset)
 			cancel()
@@ -80,56 +95,90 @@ func main() {
 		ticker := time.NewTicker(updateRate)
 		frame := 0
 		searchDone := false
+		input := ui.InputLines()
 
 		for !searchDone {
 			select {
-			case result := <-resultChan:
+			case event := <-events:
+				switch event.Type {
+				case generator.EventBest:
+					// Skip candidates queued before a pattern change
+					if p := event.Pattern; p.Prefix == config.Prefix && p.Suffix == config.Suffix && p.Contains == config.Contains {
+						ui.PrintBest(*event.Result, event.Pattern)
+					}
+					continue
+				case generator.EventWarning:
+					ui.PrintWarning(event.Err)
+					continue
+				case generator.EventProgress:
+					continue
+				}
+
+				// A match, a failure (e.g. a GPU error), a reached limit or the end of the search
 				ticker.Stop()
 				elapsed := time.Since(startTime)
-				stats := gen.Stats()
 				ui.ClearLine()
-				ui.PrintSuccess(result, elapsed, stats.Attempts, outputFile)
-				saveResult(result, elapsed, stats.Attempts)
+				switch event.Type {
+				case generator.EventMatch:
+					result := *event.Result
+					ui.PrintSuccess(result, elapsed, event.Stats.Attempts, outputFile)
+					dir := keyFilesDir(result)
+					saveResult(result, elapsed, event.Stats.Attempts, dir)
+					saveKeyFiles(result, dir)
+				case generator.EventError:
+					ui.PrintSearchError(event.Err, elapsed, event.Stats.Attempts)
+				case generator.EventNotFound:
+					ui.PrintNotFound(event.Err, event.Stats)
+				case generator.EventDone:
+					ui.PrintSearchEnded(elapsed, event.Stats.Attempts)
+				}
 				cancel()
+				drain(events)
 				signal.Stop(sigChan)
 
-				action := ui.AskToContinue()
-				switch action {
-				case ui.ActionQuit:
+				if !askToContinue(&gen) {
 					return
-				case ui.ActionSwitchNetwork:
-					fmt.Println()
-					gen, network = ui.SelectNetworkOnly()
-					currentNetwork = network
 				}
 				searchDone = true
 
+			case _, ok := <-input:
+				if !ok {
+					// Standard input was closed; keep searching
+					input = nil
+					continue
+				}
+				// Enter during the search: swap the pattern without restarting it
+				ui.ClearLine()
+				prefix, suffix, contains := ui.EditPattern(config)
+				if err := gen.UpdatePattern(prefix, suffix, contains); err != nil {
+					fmt.Printf("    %s✗ Pattern not changed: %v%s\n", ui.ColorRed, err, ui.ColorReset)
+					continue
+				}
+				config.Prefix, config.Suffix, config.Contains = prefix, suffix, contains
+				difficulty = generator.EstimateDifficulty(config)
+				ui.PrintSearchInfo(config, difficulty)
+				ui.PrintSearchKeys()
+
 			case <-ticker.C:
 				stats := gen.Stats()
-				ui.PrintProgress(stats, difficulty, frame)
+				ui.PrintProgress(stats, frame)
 				frame++
 
 			case <-sigChan:
 				ticker.Stop()
 				ui.ClearLine()
 				elapsed := time.Since(startTime)
-				stats := gen.Stats()
+				cancel()
+				stats := drain(events)
 				fmt.Println()
 				fmt.Printf("    %s⚠ Cancelled%s │ %s attempts │ %s\n",
 					ui.ColorYellow+ui.ColorBold, ui.ColorReset,
 					ui.FormatNumber(stats.Attempts),
 					ui.FormatDuration(elapsed))
-				cancel()
 				signal.Stop(sigChan)
 
-				action := ui.AskToContinue()
-				switch action {
-				case ui.ActionQuit:
+				if !askToContinue(&gen) {
 					return
-				case ui.ActionSwitchNetwork:
-					fmt.Println()
-					gen, network = ui.SelectNetworkOnly()
-					currentNetwork = network
 				}
 				searchDone = true
 			}
@@ -137,16 +186,65 @@ func main() {
 	}
 }
 
+// usage prints the command-line help.
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: hexhunter [flags]\n\n")
+	fmt.Fprintf(out, "Without -network, HexHunter asks for the network and patterns in menus.\n")
+	fmt.Fprintf(out, "With -network, it searches once and exits: %d after a match (saved to %s),\n", exitFound, outputFile)
+	fmt.Fprintf(out, "%d when a limit is reached without a match or a -type does not fit the network,\n", exitNotFound)
+	fmt.Fprintf(out, "%d on other errors.\n\n", exitFailed)
+	fmt.Fprintf(out, "Example: hexhunter -network solana -prefix Sun -max-duration 1h\n\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// drain reads a cancelled search's events until the stream is closed,
+// so the backend has fully stopped, and returns the final statistics.
+func drain(events <-chan generator.Event) generator.Stats {
+	var stats generator.Stats
+	for event := range events {
+		stats = event.Stats
+	}
+	return stats
+}
+
+// askToContinue asks what to do after a search. It returns false to quit;
+// on a network switch, gen is closed and replaced along with currentNetwork.
+func askToContinue(gen *generator.Generator) bool {
+	switch ui.AskToContinue() {
+	case ui.ActionQuit:
+		return false
+	case ui.ActionSwitchNetwork:
+		fmt.Println()
+		// Release the old backend (e.g. its OpenCL context) before the new one is created
+		(*gen).Close()
+		*gen, currentNetwork = ui.SelectNetworkOnly()
+	}
+	return true
+}
+
 // saveResult writes the result to a file
-func saveResult(result generator.Result, elapsed time.Duration, attempts uint64) {
+func saveResult(result generator.Result, elapsed time.Duration, attempts uint64, dir string) {
 	networkName := result.Network.String()
 
+	// Additional key formats (e.g. hex next to nsec)
+	exports := ""
+	for _, export := range result.Exports {
+		exports += fmt.Sprintf("%s: %s\n", export.Label, export.Value)
+	}
+	if len(result.Files) > 0 {
+		exports += fmt.Sprintf("Key Files: %s\n", dir)
+	}
+	if exports != "" {
+		exports = "\n" + exports
+	}
+
 	content := fmt.Sprintf(`%s Vanity Address
 =======================
 
 Address:     %s
 Private Key: %s
-
+%s
 Statistics:
   Time:     %s
   Attempts: %s
@@ -154,64 +252,56 @@ Statistics:
 Generated: %s
 
 ⚠️ WARNING: Keep this private key secret and secure!
-`, networkName, result.Address, result.PrivateKey, ui.FormatDuration(elapsed), ui.FormatNumber(attempts), time.Now().Format("2006-01-02 15:04:05"))
+`, networkName, result.Address, result.PrivateKey, exports, ui.FormatDuration(elapsed), ui.FormatNumber(attempts), time.Now().Format("2006-01-02 15:04:05"))
 
 	err := os.WriteFile(outputFile, []byte(content), 0600)
 	if err != nil {
-		fmt.Printf("    %s⚠ Save failed: %v%s\n", ui.ColorYellow, err, ui.ColorReset)
+		fmt.Fprintf(status, "    %s⚠ Save failed: %v%s\n", ui.ColorYellow, err, ui.ColorReset)
 	}
 }
 
-// estimateDifficulty calculates expected attempts based on network
-func estimateDifficulty(prefix, suffix, contains string, network generator.Network) uint64 {
-	totalChars := len(prefix) + len(suffix)
-	containsLen := len(contains)
-
-	if totalChars == 0 && containsLen == 0 {
-		return 1
+// saveKeyFiles writes the result's key files (e.g. hsm_secret) into dir.
+func saveKeyFiles(result generator.Result, dir string) {
+	if len(result.Files) == 0 {
+		return
 	}
 
-	difficulty := uint64(1)
-	base := uint64(16) // Hex for Ethereum
-
-	switch network {
-	case generator.Solana:
-		base = 58 // Base58 for Solana
-	case generator.Tron:
-		base = 58 // Base58 for Tron
-	case generator.Bitcoin:
-		// Bitcoin address type determines encoding:
-		// - Taproot (P2TR) / Native SegWit: Bech32/Bech32m = 32 chars
-		// - Legacy (P2PKH) / Nested SegWit (P2SH): Base58 = 58 chars
-		switch ui.SelectedBitcoinAddressType {
-		case generator.AddressTypeTaproot:
-			base = 32 // Bech32m
-		case generator.AddressTypeLegacy, generator.AddressTypeNestedSegWit:
-			base = 58 // Base58
-		default:
-			base = 32 // Default to Bech32
+	for _, file := range result.Files {
+		path := filepath.Join(dir, file.Name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			fmt.Fprintf(status, "    %s⚠ Save failed: %v%s\n", ui.ColorYellow, err, ui.ColorReset)
+			return
+		}
+		if err := os.WriteFile(path, file.Data, 0600); err != nil {
+			fmt.Fprintf(status, "    %s⚠ Save failed: %v%s\n", ui.ColorYellow, err, ui.ColorReset)
+			return
 		}
 	}
 
-	// Calculate difficulty for prefix + suffix
-	for i := 0; i < totalChars; i++ {
-		difficulty *= base
-	}
+	fmt.Fprintf(status, "    %s📁 Key files saved to %s%s\n", ui.ColorCyan, dir, ui.ColorReset)
+}
 
-	// Add contains difficulty (approximate)
-	// Contains can appear anywhere in ~20 positions, so divide by that
-	if containsLen > 0 {
-		containsDiff := uint64(1)
-		for i := 0; i < containsLen; i++ {
-			containsDiff *= base
+// keyFilesDir returns the directory for a result's key files, e.g. "lightning-02deadbeef1234".
+// The name comes from the address, with a numeric suffix when that directory already exists
+// (addresses with a long fixed start, like ssh-ed25519 keys), so results never overwrite each other.
+func keyFilesDir(result generator.Result) string {
+	// Network names and addresses may contain characters that are not valid in paths (did:key)
+	sanitize := func(r rune) rune {
+		if (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			return r
 		}
-		// Divide by ~20 possible positions (rough average)
-		containsDiff = containsDiff / 20
-		if containsDiff < 1 {
-			containsDiff = 1
+		return '_'
+	}
+	name := strings.Map(sanitize, result.Address)
+	if len(name) > 16 {
+		name = name[:16]
+	}
+	base := strings.Map(sanitize, strings.ToLower(result.Network.String())) + "-" + name
+	dir := base
+	for i := 2; ; i++ {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			return dir
 		}
-		difficulty *= containsDiff
+		dir = fmt.Sprintf("%s-%d", base, i)
 	}
-
-	return difficulty
 }
@@ -3,6 +3,7 @@ module github.com/Amr-9/HexHunter
 go 1.24.9
 
 require (
+	filippo.io/edwards25519 v1.1.0
 	github.com/btcsuite/btcd/btcec/v2 v2.3.6
 	github.com/btcsuite/btcd/btcutil v1.1.6
 	github.com/ethereum/go-ethereum v1.16.7
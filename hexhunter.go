@@ -0,0 +1,412 @@
+// Package hexhunter is the library API of HexHunter. It searches vanity addresses
+// for any registered network from Go code, without the interactive UI:
+//
+//	results, err := hexhunter.Search(ctx, hexhunter.Options{
+//		Config: generator.Config{Network: generator.Ethereum, Prefix: "dead"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	for result := range results {
+//		fmt.Println(result.Address, result.PrivateKey)
+//	}
+//
+// All built-in networks are registered by importing this package.
+package hexhunter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/Amr-9/HexHunter/pkg/generator/cpu"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/networks" // Register all built-in networks
+)
+
+// Engine selects the search backend.
+type Engine int
+
+const (
+	EngineAuto Engine = iota // GPU if the network has one and it starts, otherwise CPU
+	EngineCPU                // CPU workers only
+	EngineGPU                // GPU only; fails if the network has none or it cannot start
+)
+
+// String returns the engine name.
+func (e Engine) String() string {
+	switch e {
+	case EngineAuto:
+		return "Auto"
+	case EngineCPU:
+		return "CPU"
+	case EngineGPU:
+		return "GPU"
+	default:
+		return "Unknown"
+	}
+}
+
+// Options configures a search.
+type Options struct {
+	generator.Config        // Network, patterns and network settings (Workers 0 = every CPU core)
+	Engine           Engine // Search backend
+	Count            int    // Results to find before the iteration ends (0 = 1, negative = until ctx is cancelled)
+}
+
+// Searcher is a prepared search: the engine is selected, the patterns are validated
+// and the difficulty is estimated. It runs once, with Run, and its engine is closed
+// when the iteration ends.
+type Searcher struct {
+	config  generator.Config
+	info    generator.NetworkInfo
+	pattern *generator.Pattern
+	engine  Engine
+	count   int
+
+	mu          sync.Mutex
+	gen         generator.Generator
+	gpu         bool                // gen is the network's GPU backend
+	running     bool                // gen has a search in progress
+	attempts    uint64              // Attempts of finished runs
+	probability float64             // Probability of the last finished run, kept until the next one starts
+	startTime   time.Time           // When the first run started
+	deadline    time.Time           // When the whole search stops: the earliest of Deadline and MaxDuration
+	rate        generator.RateMeter // Recent hash rate of the whole search
+	err         error               // Error that ended the iteration early
+}
+
+// Search prepares a search with NewSearcher and runs it.
+func Search(ctx context.Context, opts Options) (iter.Seq[generator.Result], error) {
+	s, err := NewSearcher(opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Run(ctx)
+}
+
+// NewSearcher validates the options and selects the engine.
+func NewSearcher(opts Options) (*Searcher, error) {
+	info, ok := generator.Lookup(opts.Network)
+	if !ok {
+		return nil, fmt.Errorf("network %d is not registered", int(opts.Network))
+	}
+
+	config := opts.Config
+	pattern, err := generator.CompilePattern(&config)
+	if err != nil {
+		return nil, err
+	}
+	if pattern.Empty() {
+		return nil, fmt.Errorf("must specify prefix, suffix, or contains")
+	}
+	// Engines get the cleaned patterns (e.g. without a typed "0x")
+	config.Prefix, config.Suffix, config.Contains = pattern.Prefix, pattern.Suffix, pattern.Contains
+
+	gen, gpu, err := newEngine(info, opts.Engine, config.Workers)
+	if err != nil {
+		return nil, err
+	}
+
+	count := opts.Count
+	if count == 0 {
+		count = 1
+	}
+
+	return &Searcher{
+		config:  config,
+		info:    info,
+		pattern: pattern,
+		engine:  opts.Engine,
+		count:   count,
+		gen:     gen,
+		gpu:     gpu,
+	}, nil
+}
+
+// newEngine creates the generator for an engine choice and reports whether it is a GPU backend.
+func newEngine(info generator.NetworkInfo, engine Engine, workers int) (generator.Generator, bool, error) {
+	switch engine {
+	case EngineAuto:
+		if info.GPU != nil {
+			if gen, err := info.GPU(); err == nil {
+				return gen, true, nil
+			}
+		}
+		if info.NewWorker == nil {
+			return nil, false, fmt.Errorf("%s has no CPU search and its GPU is unavailable", info.Name)
+		}
+		return cpu.NewCPUGenerator(workers), false, nil
+	case EngineCPU:
+		if info.NewWorker == nil {
+			return nil, false, fmt.Errorf("%s has no CPU search", info.Name)
+		}
+		return cpu.NewCPUGenerator(workers), false, nil
+	case EngineGPU:
+		if info.GPU == nil {
+			return nil, false, fmt.Errorf("%s has no GPU search", info.Name)
+		}
+		gen, err := info.GPU()
+		if err != nil {
+			return nil, false, fmt.Errorf("%s GPU: %w", info.Name, err)
+		}
+		return gen, true, nil
+	default:
+		return nil, false, fmt.Errorf("unknown engine %d", int(engine))
+	}
+}
+
+// Name returns the name of the selected engine (e.g. "CPU").
+func (s *Searcher) Name() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gen.Name()
+}
+
+// Network returns the descriptor of the searched network.
+func (s *Searcher) Network() generator.NetworkInfo {
+	return s.info
+}
+
+// Pattern returns the cleaned and validated pattern.
+func (s *Searcher) Pattern() *generator.Pattern {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pattern
+}
+
+// Difficulty returns the expected number of attempts per result.
+func (s *Searcher) Difficulty() uint64 {
+	return s.Pattern().Difficulty()
+}
+
+// UpdatePattern replaces the prefix, suffix and contains patterns without restarting
+// the engine. The running search switches to them right away and the following
+// results are searched with them. On error the patterns are unchanged.
+func (s *Searcher) UpdatePattern(prefix, suffix, contains string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pattern, err := s.pattern.Replace(prefix, suffix, contains)
+	if err != nil {
+		return err
+	}
+	if s.running {
+		// The patterns are already validated, so this only fails if the run has just
+		// ended with a match; the next run starts with s.config anyway.
+		_ = s.gen.UpdatePattern(pattern.Prefix, pattern.Suffix, pattern.Contains)
+	}
+	s.pattern = pattern
+	s.config.Prefix, s.config.Suffix, s.config.Contains = pattern.Prefix, pattern.Suffix, pattern.Contains
+	return nil
+}
+
+// Stats returns the performance statistics of the whole search, across all results.
+// Probability is that of the result being searched for, or that of the last one
+// once the search has ended. It is safe to call from any goroutine.
+func (s *Searcher) Stats() generator.Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts, probability := s.attempts, s.probability
+	if s.running {
+		stats := s.gen.Stats()
+		attempts += stats.Attempts
+		probability = stats.Probability
+	}
+	now := time.Now()
+	var elapsed, hashRate, recent float64
+	if !s.startTime.IsZero() {
+		elapsed = now.Sub(s.startTime).Seconds()
+		recent = s.rate.Sample(attempts, now)
+	}
+	if elapsed > 0 {
+		hashRate = float64(attempts) / elapsed
+	}
+	return generator.Stats{
+		Attempts:       attempts,
+		HashRate:       hashRate,
+		RecentHashRate: recent,
+		ElapsedSecs:    elapsed,
+		Difficulty:     s.pattern.Difficulty(),
+		Probability:    probability,
+	}
+}
+
+// Err returns the error that ended the iteration early, if any.
+// Cancelling ctx is not an error. When the search reaches a limit of the options
+// (MaxAttempts, MaxDuration or Deadline), the error wraps generator.ErrNotFound.
+func (s *Searcher) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close stops the search and releases the engine (e.g. its OpenCL context).
+// A running iteration ends as if ctx was cancelled. Calling it again does nothing.
+func (s *Searcher) Close() error {
+	s.mu.Lock()
+	gen := s.gen
+	s.mu.Unlock()
+	return gen.Close()
+}
+
+// Run starts the search and returns its results. Errors starting the engine
+// (e.g. a missing network setting) are returned before any result.
+//
+// The search keeps running in the background until the returned sequence is iterated,
+// so iterate it, cancel ctx or call Close. Each result is searched for after the previous
+// one is consumed. The iteration ends after Count results, when ctx is cancelled, when
+// the loop breaks, when the engine fails or when a limit is reached (see Err); the sequence
+// can only be iterated once. The limits bound the whole search, across all results.
+// The engine has fully stopped and is closed when the iteration ends.
+func (s *Searcher) Run(ctx context.Context) (iter.Seq[generator.Result], error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	events, err := s.start(runCtx)
+	if err != nil {
+		cancel()
+		s.Close()
+		return nil, err
+	}
+
+	return func(yield func(generator.Result) bool) {
+		// events is nil once the current run has been read to the end
+		defer func() {
+			cancel()
+			if events != nil {
+				// The loop broke during a run
+				s.finish(drain(events))
+			}
+			s.Close()
+		}()
+
+		for found := 0; ; {
+			event, ok := <-events
+			if !ok {
+				events = nil
+				return
+			}
+			switch event.Type {
+			case generator.EventMatch:
+				cancel()
+				s.finish(drain(events))
+				events = nil
+				found++
+				if !yield(*event.Result) || (s.count > 0 && found >= s.count) {
+					return
+				}
+
+				runCtx, cancel = context.WithCancel(ctx)
+				if events, err = s.start(runCtx); err != nil {
+					// Closed while the result was being consumed
+					if !errors.Is(err, generator.ErrClosed) {
+						s.fail(err)
+					}
+					return
+				}
+			case generator.EventError:
+				s.finish(event.Stats)
+				events = nil
+				s.fail(event.Err)
+				return
+			case generator.EventNotFound:
+				s.finish(event.Stats)
+				events = nil
+				s.fail(s.limitError(event.Err))
+				return
+			case generator.EventDone:
+				// Cancelled or closed before a match
+				s.finish(event.Stats)
+				events = nil
+				return
+			}
+		}
+	}, nil
+}
+
+// drain reads a stopping run's events until the stream is closed and returns its final statistics.
+func drain(events <-chan generator.Event) generator.Stats {
+	var stats generator.Stats
+	for event := range events {
+		stats = event.Stats
+	}
+	return stats
+}
+
+// start runs the engine once, until it finds a result.
+// With EngineAuto a GPU that fails to start is replaced by the CPU.
+func (s *Searcher) start(ctx context.Context) (<-chan generator.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.startTime.IsZero() {
+		s.startTime = time.Now()
+		s.rate.Sample(0, s.startTime)
+		s.deadline = s.config.Deadline
+		if end := s.startTime.Add(s.config.MaxDuration); s.config.MaxDuration > 0 && (s.deadline.IsZero() || end.Before(s.deadline)) {
+			s.deadline = end
+		}
+	}
+
+	// Each run gets what is left of the limits of the whole search
+	config := s.config
+	config.MaxDuration, config.Deadline = 0, s.deadline
+	if s.config.MaxAttempts > 0 {
+		if s.attempts >= s.config.MaxAttempts {
+			return nil, s.limitErrorLocked(nil)
+		}
+		config.MaxAttempts = s.config.MaxAttempts - s.attempts
+	}
+
+	events, err := s.gen.Start(ctx, &config)
+	if err != nil && s.gpu && s.engine == EngineAuto && s.info.NewWorker != nil && !errors.Is(err, generator.ErrClosed) {
+		s.gen.Close()
+		s.gen, s.gpu = cpu.NewCPUGenerator(s.config.Workers), false
+		events, err = s.gen.Start(ctx, &config)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.running = true
+	return events, nil
+}
+
+// limitError describes the limit of the whole search that a run reached. The run only
+// knew what was left of it, so its cause (e.g. "within 12 attempts") is rewritten.
+func (s *Searcher) limitError(cause error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.limitErrorLocked(cause)
+}
+
+// limitErrorLocked is limitError with s.mu held.
+func (s *Searcher) limitErrorLocked(cause error) error {
+	switch {
+	case s.config.MaxAttempts > 0 && s.attempts >= s.config.MaxAttempts:
+		return fmt.Errorf("%w within %d attempts", generator.ErrNotFound, s.config.MaxAttempts)
+	case s.config.MaxDuration > 0 && !s.deadline.Equal(s.config.Deadline):
+		return fmt.Errorf("%w within %s", generator.ErrNotFound, s.config.MaxDuration)
+	default:
+		return cause
+	}
+}
+
+// finish adds the final statistics of a run to the totals.
+func (s *Searcher) finish(stats generator.Stats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts += stats.Attempts
+	s.probability = stats.Probability
+	s.running = false
+}
+
+// fail records the error that ended the iteration.
+func (s *Searcher) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
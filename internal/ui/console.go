@@ -48,111 +48,86 @@ func PrintWelcomeBanner(version string) {
 func PrintSearchInfo(config *generator.Config, difficulty uint64) {
 	fmt.Printf("\n    %s🚀 SEARCHING%s", ColorGreen+ColorBold, ColorReset)
 
-	switch config.Network {
-	case generator.Solana:
-		// Solana format (no prefix)
-		if config.Prefix != "" {
-			fmt.Printf(" %s%s%s%s", ColorBold, ColorCyan, config.Prefix, ColorReset)
-		}
-		if config.Contains != "" {
-			fmt.Printf("%s...%s%s%s", ColorDim, ColorCyan+ColorBold, config.Contains, ColorReset)
-			if config.Suffix == "" {
-				fmt.Printf("%s...%s", ColorDim, ColorReset)
-			}
-		} else if config.Prefix != "" {
-			fmt.Printf("%s...%s", ColorDim, ColorReset)
-		}
-		if config.Suffix != "" {
-			fmt.Printf("%s...%s%s%s%s", ColorDim, ColorCyan, ColorBold, config.Suffix, ColorReset)
-		}
-	case generator.Bitcoin:
-		// Bitcoin format (bc1p, 1, or 3 prefix based on address type)
-		prefix := "bc1p"
-		switch SelectedBitcoinAddressType {
-		case generator.AddressTypeLegacy:
-			prefix = "1"
-		case generator.AddressTypeNestedSegWit:
-			prefix = "3"
-		}
-		// Build pattern: prefix + userPrefix + ...contains... + suffix
-		fmt.Printf(" %s%s%s%s%s", ColorBold, ColorCyan, prefix, config.Prefix, ColorReset)
-		if config.Contains != "" {
-			fmt.Printf("%s...%s%s%s", ColorDim, ColorCyan+ColorBold, config.Contains, ColorReset)
-			if config.Suffix == "" {
-				fmt.Printf("%s...%s", ColorDim, ColorReset)
-			}
-		}
-		if config.Suffix != "" {
-			fmt.Printf("%s...%s%s%s%s", ColorDim, ColorCyan, ColorBold, config.Suffix, ColorReset)
-		} else if config.Contains == "" && config.Prefix != "" {
-			fmt.Printf("%s...%s", ColorDim, ColorReset)
-		}
-	case generator.Tron:
-		// Tron format (T prefix)
-		if config.Prefix != "" {
-			fmt.Printf(" %s%sT%s%s", ColorBold, ColorCyan, config.Prefix, ColorReset)
-		} else {
-			fmt.Printf(" %s%sT%s", ColorBold, ColorCyan, ColorReset)
-		}
-		if config.Contains != "" {
-			fmt.Printf("%s...%s%s%s", ColorDim, ColorCyan+ColorBold, config.Contains, ColorReset)
-			if config.Suffix == "" {
-				fmt.Printf("%s...%s", ColorDim, ColorReset)
-			}
-		} else if config.Prefix != "" {
-			fmt.Printf("%s...%s", ColorDim, ColorReset)
-		}
-		if config.Suffix != "" {
-			fmt.Printf("%s...%s%s%s%s", ColorDim, ColorCyan, ColorBold, config.Suffix, ColorReset)
-		}
-	default:
-		// Ethereum/Aptos/Sui format (0x prefix)
-		fmt.Printf(" %s%s0x%s%s", ColorBold, ColorCyan, config.Prefix, ColorReset)
-		if config.Contains != "" {
-			fmt.Printf("%s...%s%s%s", ColorDim, ColorCyan+ColorBold, config.Contains, ColorReset)
-			if config.Suffix == "" {
-				fmt.Printf("%s...%s", ColorDim, ColorReset)
-			}
-		} else if config.Prefix != "" {
+	// Fixed leading characters of the network (0x, bc1p, T, npub1...) followed by the prefix
+	fixed := ""
+	if info, ok := generator.Lookup(config.Network); ok {
+		fixed = info.Rules(config).Fixed
+	}
+	if start := fixed + config.Prefix; start != "" {
+		fmt.Printf(" %s%s%s%s", ColorBold, ColorCyan, start, ColorReset)
+	}
+	if config.Contains != "" {
+		fmt.Printf("%s...%s%s%s", ColorDim, ColorCyan+ColorBold, config.Contains, ColorReset)
+		if config.Suffix == "" {
 			fmt.Printf("%s...%s", ColorDim, ColorReset)
 		}
-		if config.Suffix != "" {
-			fmt.Printf("%s...%s%s%s%s", ColorDim, ColorCyan, ColorBold, config.Suffix, ColorReset)
-		}
+	} else if config.Prefix != "" {
+		fmt.Printf("%s...%s", ColorDim, ColorReset)
+	}
+	if config.Suffix != "" {
+		fmt.Printf("%s...%s%s%s%s", ColorDim, ColorCyan, ColorBold, config.Suffix, ColorReset)
 	}
 
-	fmt.Printf(" %s(1/%s)%s\n\n", ColorDim, FormatNumber(difficulty), ColorReset)
+	fmt.Printf(" %s(1/%s)%s\n", ColorDim, FormatDifficulty(difficulty), ColorReset)
 }
 
-// PrintProgress shows animated progress bar
-func PrintProgress(stats generator.Stats, difficulty uint64, frame int) {
+// PrintSearchKeys shows the keys that control an interactive search
+func PrintSearchKeys() {
+	fmt.Printf("    %s[Enter] Change pattern  │  [Ctrl+C] Cancel%s\n\n", ColorDim, ColorReset)
+}
+
+// PrintProgress shows animated progress bar: the probability that a match was found
+// by now, the recent speed, and the expected time until the probability reaches 50%, 90% and 99%
+func PrintProgress(stats generator.Stats, frame int) {
 	spinners := []string{"◐", "◓", "◑", "◒"}
 	spinner := spinners[frame%len(spinners)]
 
-	attempts := float64(stats.Attempts)
-	diff := float64(difficulty)
-	if diff == 0 {
-		diff = 1
-	}
-
-	ratio := attempts / diff
-	progress := 1.0 - math.Pow(0.5, 2.0*ratio)
-
-	barWidth := 40
-	filled := int(progress * float64(barWidth))
+	barWidth := 16
+	filled := int(stats.Probability * float64(barWidth))
 	if filled > barWidth {
 		filled = barWidth
 	}
 	bar := strings.Repeat("▓", filled) + strings.Repeat("░", barWidth-filled)
 
-	speedStr := FormatHashRate(stats.HashRate)
+	// The recent rate follows a GPU warming up or throttling; it needs a moment to be measured
+	rate := stats.RecentHashRate
+	if rate == 0 {
+		rate = stats.HashRate
+	}
+	speedStr := FormatHashRate(rate)
 
-	fmt.Printf("\r    %s%s%s %s%s%s %s%s%s │ %s%s%s │ %s",
+	fmt.Printf("\r    %s%s%s %s%s%s %s%s%s │ %s%s%s │ %s%s%s │ %s │ %sETA%s %s",
 		ColorCyan, spinner, ColorReset,
 		ColorDim, bar, ColorReset,
+		ColorBold, FormatProbability(stats.Probability), ColorReset,
 		ColorGreen+ColorBold, speedStr, ColorReset,
 		ColorYellow, FormatNumber(stats.Attempts), ColorReset,
-		FormatDuration(time.Duration(stats.ElapsedSecs*float64(time.Second))))
+		FormatDuration(time.Duration(stats.ElapsedSecs*float64(time.Second))),
+		ColorDim, ColorReset, formatETAs(stats))
+}
+
+// formatETAs formats the expected time until the probability of a match reaches 50%, 90% and 99%,
+// e.g. "50% 12.0s · 90% 40.1s · 99% 1m 20s". Reached ones show a check mark.
+func formatETAs(stats generator.Stats) string {
+	etas := make([]string, 0, 3)
+	for _, p := range []float64{0.5, 0.9, 0.99} {
+		eta := "…"
+		if d, ok := stats.TimeTo(p); ok && d == 0 {
+			eta = "✓"
+		} else if ok {
+			eta = FormatDuration(d)
+		}
+		etas = append(etas, fmt.Sprintf("%s%.0f%%%s %s", ColorDim, p*100, ColorReset, eta))
+	}
+	return strings.Join(etas, " · ")
+}
+
+// FormatProbability formats a probability as a percentage, e.g. "37.2%"
+func FormatProbability(p float64) string {
+	if p > 0 && p < 0.001 {
+		return "<0.1%"
+	}
+	return fmt.Sprintf("%.1f%%", math.Min(p, 1)*100)
 }
 
 // FormatHashRate formats hash rate nicely
@@ -173,19 +148,8 @@ func PrintSuccess(result generator.Result, elapsed time.Duration, attempts uint6
 	fmt.Printf("    %s%s╚══════════════════════════════════════════════════════════╝%s\n\n", ColorGreen, ColorBold, ColorReset)
 
 	networkLabel := "📍 ADDRESS"
-	switch result.Network {
-	case generator.Solana:
-		networkLabel = "◎ SOLANA ADDRESS"
-	case generator.Aptos:
-		networkLabel = "◆ APTOS ADDRESS"
-	case generator.Sui:
-		networkLabel = "◇ SUI ADDRESS"
-	case generator.Bitcoin:
-		networkLabel = "₿ BITCOIN ADDRESS"
-	case generator.Tron:
-		networkLabel = "₮ TRON ADDRESS"
-	default:
-		networkLabel = "⟠ ETHEREUM ADDRESS"
+	if info, ok := generator.Lookup(result.Network); ok && info.ResultLabel != "" {
+		networkLabel = info.Icon + " " + info.ResultLabel
 	}
 
 	fmt.Printf("    %s%s%s\n", ColorCyan+ColorBold, networkLabel, ColorReset)
@@ -196,6 +160,11 @@ func PrintSuccess(result generator.Result, elapsed time.Duration, attempts uint6
 	fmt.Printf("    %s🔑 PRIVATE KEY%s\n", ColorPurple+ColorBold, ColorReset)
 	fmt.Printf("       %s%s%s\n\n", ColorYellow, result.PrivateKey, ColorReset)
 
+	for _, export := range result.Exports {
+		fmt.Printf("    %s%s%s\n", ColorPurple, export.Label, ColorReset)
+		fmt.Printf("       %s%s%s\n\n", ColorYellow, export.Value, ColorReset)
+	}
+
 	fmt.Printf("    %s⏱   %s%s   %s│   %s📊  %s%s   %s│   %s💾  %s%s%s\n\n",
 		ColorCyan, ColorReset+ColorBold, FormatDuration(elapsed),
 		ColorDim,
@@ -206,9 +175,48 @@ func PrintSuccess(result generator.Result, elapsed time.Duration, attempts uint6
 	fmt.Printf("    %s%s⚠  KEEP YOUR PRIVATE KEY SECRET!%s\n", ColorRed, ColorBold, ColorReset)
 }
 
+// PrintBest shows the closest candidate found so far above the progress bar,
+// with how many prefix and suffix characters it matches
+func PrintBest(result generator.Result, pattern *generator.Pattern) {
+	ClearLine()
+	fmt.Printf("    %s★ Closest so far:%s %s %s(%d/%d)%s\n",
+		ColorYellow, ColorReset, result.Address,
+		ColorDim, result.Partial, len(pattern.Prefix)+len(pattern.Suffix), ColorReset)
+}
+
+// PrintWarning shows a problem the search recovered from above the progress bar
+func PrintWarning(err error) {
+	ClearLine()
+	fmt.Printf("    %s⚠ %v%s\n", ColorYellow, err, ColorReset)
+}
+
+// PrintSearchError shows why a search stopped without a result
+func PrintSearchError(err error, elapsed time.Duration, attempts uint64) {
+	fmt.Println()
+	fmt.Printf("    %s✗ Search failed:%s %v\n", ColorRed+ColorBold, ColorReset, err)
+	fmt.Printf("    %s%s attempts │ %s%s\n", ColorDim, FormatNumber(attempts), FormatDuration(elapsed), ColorReset)
+}
+
+// PrintSearchEnded shows that a search ended without a result or an error
+func PrintSearchEnded(elapsed time.Duration, attempts uint64) {
+	fmt.Println()
+	fmt.Printf("    %s■ Search ended%s │ %s attempts │ %s\n", ColorBold, ColorReset, FormatNumber(attempts), FormatDuration(elapsed))
+}
+
+// PrintNotFound shows that a search reached its limit without a result, with its final
+// statistics: how far it got and the chance it had of finding a match by then
+func PrintNotFound(err error, stats generator.Stats) {
+	elapsed := time.Duration(stats.ElapsedSecs * float64(time.Second))
+	fmt.Println()
+	fmt.Printf("    %s⚠ Not found:%s %v\n", ColorYellow+ColorBold, ColorReset, err)
+	fmt.Printf("    %s%s attempts │ %s │ %s │ %s chance of a match by now%s\n", ColorDim,
+		FormatNumber(stats.Attempts), FormatDuration(elapsed), FormatHashRate(stats.HashRate),
+		FormatProbability(stats.Probability), ColorReset)
+}
+
 // ClearLine clears the current line
 func ClearLine() {
-	fmt.Print("\r                                                                                              \r")
+	fmt.Print("\r\033[2K")
 }
 
 // WaitForExit waits for user to press Enter before exiting
@@ -235,6 +243,14 @@ func FormatNumber(n uint64) string {
 	return string(result)
 }
 
+// FormatDifficulty formats a difficulty like FormatNumber; the capped maximum shows as "> 18,446,744,073,709,551,615"
+func FormatDifficulty(d uint64) string {
+	if d == math.MaxUint64 {
+		return "> " + FormatNumber(d)
+	}
+	return FormatNumber(d)
+}
+
 // FormatDuration formats duration in a human-readable way
 func FormatDuration(d time.Duration) string {
 	if d < time.Second {
@@ -248,7 +264,15 @@ func FormatDuration(d time.Duration) string {
 		s := int(d.Seconds()) % 60
 		return fmt.Sprintf("%dm %ds", m, s)
 	}
-	h := int(d.Hours())
-	m := int(d.Minutes()) % 60
-	return fmt.Sprintf("%dh %dm", h, m)
+	if d < 24*time.Hour {
+		h := int(d.Hours())
+		m := int(d.Minutes()) % 60
+		return fmt.Sprintf("%dh %dm", h, m)
+	}
+	if d < 365*24*time.Hour {
+		days := int(d.Hours()) / 24
+		h := int(d.Hours()) % 24
+		return fmt.Sprintf("%dd %dh", days, h)
+	}
+	return fmt.Sprintf("%.1fy", d.Hours()/(365*24))
 }
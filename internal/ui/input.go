@@ -2,23 +2,32 @@ package ui
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Amr-9/HexHunter/pkg/generator"
-	"github.com/Amr-9/HexHunter/pkg/generator/aptos"
-	"github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+	"github.com/Amr-9/HexHunter/pkg/generator/cardano"
 	"github.com/Amr-9/HexHunter/pkg/generator/cpu"
 	"github.com/Amr-9/HexHunter/pkg/generator/ethereum"
-	"github.com/Amr-9/HexHunter/pkg/generator/solana"
-	"github.com/Amr-9/HexHunter/pkg/generator/sui"
-	"github.com/Amr-9/HexHunter/pkg/generator/tron"
+	"github.com/Amr-9/HexHunter/pkg/generator/openpgp"
+	"github.com/Amr-9/HexHunter/pkg/generator/openssh"
+	"github.com/Amr-9/HexHunter/pkg/generator/substrate"
+	"github.com/Amr-9/HexHunter/pkg/generator/ton"
 )
 
-// SelectedBitcoinAddressType holds the selected Bitcoin address type (global for simplicity)
-var SelectedBitcoinAddressType generator.AddressType = generator.AddressTypeTaproot
+// SelectedAddressType holds the selected address type for networks with variants
+// (Bitcoin address format, Lightning mode, XRPL key type). Global for simplicity.
+var SelectedAddressType generator.AddressType = generator.AddressTypeDefault
+
+// SelectedOptions holds the network options chosen during network selection
+// (SS58 network, stake key, TON workchain, key owner...), see generator.NetworkInfo.Options
+var SelectedOptions map[string]string
 
 // selectedUseGPU tracks whether GPU was selected (for network switching)
 var selectedUseGPU bool = false
@@ -26,7 +35,7 @@ var selectedUseGPU bool = false
 // SelectEngineAndNetwork handles the engine (CPU/GPU) and network (Ethereum/Solana/Bitcoin) selection.
 // Returns the appropriate generator and selected network.
 func SelectEngineAndNetwork() (generator.Generator, generator.Network) {
-	reader := bufio.NewReader(os.Stdin)
+	reader := bufio.NewReader(stdin)
 
 	// Step 1: Select Engine
 	fmt.Printf("    %s⚡ SELECT ENGINE%s\n", ColorPurple+ColorBold, ColorReset)
@@ -63,266 +72,182 @@ func SelectEngineAndNetwork() (generator.Generator, generator.Network) {
 // SelectNetworkOnly allows switching network without re-selecting engine.
 // Uses the previously selected engine (CPU/GPU).
 func SelectNetworkOnly() (generator.Generator, generator.Network) {
-	reader := bufio.NewReader(os.Stdin)
+	reader := bufio.NewReader(stdin)
 	return selectNetworkWithEngine(reader, selectedUseGPU)
 }
 
+// networkSetup holds the extra prompts of networks with variants or options,
+// run after the network is selected. They store their choices in SelectedAddressType and SelectedOptions.
+var networkSetup = map[generator.Network]func(reader *bufio.Reader){
+	generator.Bitcoin: func(reader *bufio.Reader) {
+		SelectedAddressType = selectBitcoinAddressType(reader)
+	},
+	generator.Lightning: func(reader *bufio.Reader) {
+		SelectedAddressType = selectLightningMode(reader)
+	},
+	generator.XRPL: func(reader *bufio.Reader) {
+		SelectedAddressType = selectXRPLKeyType(reader)
+	},
+	generator.Substrate: func(reader *bufio.Reader) {
+		SelectedOptions[substrate.OptionNetworkID] = strconv.Itoa(int(selectSubstrateNetwork(reader)))
+	},
+	generator.Cardano: func(reader *bufio.Reader) {
+		var stakeKey string
+		SelectedAddressType, stakeKey = selectCardanoAddressType(reader)
+		if stakeKey != "" {
+			SelectedOptions[cardano.OptionStakeKey] = stakeKey
+		}
+	},
+	generator.TON: func(reader *bufio.Reader) {
+		var workchain int8
+		var bounceable bool
+		SelectedAddressType, workchain, bounceable = selectTONWallet(reader)
+		SelectedOptions[ton.OptionWorkchain] = strconv.Itoa(int(workchain))
+		SelectedOptions[ton.OptionBounceable] = strconv.FormatBool(bounceable)
+	},
+	generator.SSH: func(reader *bufio.Reader) {
+		var comment string
+		SelectedAddressType, comment = selectSSHMode(reader)
+		SelectedOptions[openssh.OptionComment] = comment
+	},
+	generator.OpenPGP: func(reader *bufio.Reader) {
+		var userID string
+		var window time.Duration
+		SelectedAddressType, userID, window = selectPGPMode(reader)
+		SelectedOptions[openpgp.OptionUserID] = userID
+		SelectedOptions[openpgp.OptionTimeWindow] = window.String()
+	},
+	generator.X25519: func(reader *bufio.Reader) {
+		SelectedAddressType = selectX25519Format(reader)
+	},
+}
+
 // selectNetworkWithEngine handles the network selection with a specified engine.
+// The menu lists every registered network in ID order.
 func selectNetworkWithEngine(reader *bufio.Reader, useGPU bool) (generator.Generator, generator.Network) {
+	networks := generator.Networks()
+
 	fmt.Printf("    %s🌐 SELECT NETWORK%s\n", ColorPurple+ColorBold, ColorReset)
-	fmt.Printf("    %s[1]%s ⟠ Ethereum (ETH) %s- 0x prefix, Hex%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	if useGPU {
-		fmt.Printf(" ⚡\n")
-	} else {
-		fmt.Printf("\n")
-	}
-	fmt.Printf("    %s[2]%s ◎ Solana (SOL) %s- Base58%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	if useGPU {
-		fmt.Printf(" ⚡\n")
-	} else {
-		fmt.Printf("\n")
-	}
-	fmt.Printf("    %s[3]%s ◆ Aptos (APT) %s- 0x prefix, Hex%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	if useGPU {
-		fmt.Printf(" ⚡\n")
-	} else {
-		fmt.Printf("\n")
-	}
-	fmt.Printf("    %s[4]%s ◇ Sui (SUI) %s- 0x prefix, Hex%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	if useGPU {
-		fmt.Printf(" ⚡\n")
-	} else {
-		fmt.Printf("\n")
-	}
-	fmt.Printf("    %s[5]%s ₿ Bitcoin (BTC) %s- Taproot/Legacy/SegWit%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	fmt.Printf(" %s(CPU only)%s\n", ColorDim, ColorReset)
-	fmt.Printf("    %s[6]%s ₮ Tron (TRX) %s- Base58, T prefix%s", ColorCyan, ColorReset, ColorDim, ColorReset)
-	if useGPU {
-		fmt.Printf(" ⚡\n")
-	} else {
-		fmt.Printf("\n")
+	for i, info := range networks {
+		fmt.Printf("    %s[%d]%s %s %s %s- %s%s", ColorCyan, i+1, ColorReset, info.Icon, info.Title, ColorDim, info.Description, ColorReset)
+		switch {
+		case info.GPU == nil:
+			fmt.Printf(" %s(CPU only)%s\n", ColorDim, ColorReset)
+		case useGPU:
+			fmt.Printf(" ⚡\n")
+		default:
+			fmt.Printf("\n")
+		}
 	}
 
 	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
 	networkChoice, _ := reader.ReadString('\n')
 	networkChoice = strings.TrimSpace(networkChoice)
 
-	// Create the appropriate generator
-	var gen generator.Generator
-	var network generator.Network
-
-	switch networkChoice {
-	case "2": // Solana
-		network = generator.Solana
-		fmt.Printf("    %s✓ Solana Selected%s\n\n", ColorGreen, ColorReset)
-		if useGPU {
-			solGPU, err := solana.NewSolanaGPUGenerator()
-			if err != nil {
-				fmt.Printf("    %s⚠ Solana GPU failed: %v%s\n", ColorRed, err, ColorReset)
-				fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
-				gen = cpu.NewCPUGenerator(0)
-			} else {
-				gen = solGPU
-			}
-		} else {
-			gen = cpu.NewCPUGenerator(0)
-		}
-	case "3": // Aptos
-		network = generator.Aptos
-		fmt.Printf("    %s✓ Aptos Selected%s\n\n", ColorGreen, ColorReset)
-		if useGPU {
-			aptosGPU, err := aptos.NewAptosGPUGenerator()
-			if err != nil {
-				fmt.Printf("    %s⚠ Aptos GPU failed: %v%s\n", ColorRed, err, ColorReset)
-				fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
-				gen = cpu.NewCPUGenerator(0)
-			} else {
-				gen = aptosGPU
-			}
-		} else {
-			gen = cpu.NewCPUGenerator(0)
-		}
-	case "4": // Sui
-		network = generator.Sui
-		fmt.Printf("    %s✓ Sui Selected%s\n\n", ColorGreen, ColorReset)
-		if useGPU {
-			suiGPU, err := sui.NewSuiGPUGenerator()
-			if err != nil {
-				fmt.Printf("    %s⚠ Sui GPU failed: %v%s\n", ColorRed, err, ColorReset)
-				fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
-				gen = cpu.NewCPUGenerator(0)
-			} else {
-				gen = suiGPU
-			}
-		} else {
-			gen = cpu.NewCPUGenerator(0)
-		}
-	case "5": // Bitcoin
-		network = generator.Bitcoin
-		fmt.Printf("    %s✓ Bitcoin Selected%s\n\n", ColorGreen, ColorReset)
-		// Bitcoin is CPU-only for now
-		gen = cpu.NewCPUGenerator(0)
-		// Select address type
-		SelectedBitcoinAddressType = selectBitcoinAddressType(reader)
-	case "6": // Tron
-		network = generator.Tron
-		fmt.Printf("    %s✓ Tron Selected%s\n\n", ColorGreen, ColorReset)
-		if useGPU {
-			tronGPU, err := tron.NewTronGPUGenerator()
-			if err != nil {
-				fmt.Printf("    %s⚠ Tron GPU failed: %v%s\n", ColorRed, err, ColorReset)
-				fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
-				gen = cpu.NewCPUGenerator(0)
-			} else {
-				gen = tronGPU
-			}
-		} else {
-			gen = cpu.NewCPUGenerator(0)
-		}
-	default: // Ethereum
-		network = generator.Ethereum
-		fmt.Printf("    %s✓ Ethereum Selected%s\n\n", ColorGreen, ColorReset)
-		if useGPU {
-			ethGPU, err := ethereum.NewGPUGenerator()
-			if err != nil {
-				fmt.Printf("    %s⚠ GPU failed: %v%s\n", ColorRed, err, ColorReset)
-				fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
-				gen = cpu.NewCPUGenerator(0)
-			} else {
-				gen = ethGPU
-			}
-		} else {
-			gen = cpu.NewCPUGenerator(0)
-		}
+	// Default to the first network (Ethereum)
+	info := networks[0]
+	if n, err := strconv.Atoi(networkChoice); err == nil && n >= 1 && n <= len(networks) {
+		info = networks[n-1]
 	}
+	fmt.Printf("    %s✓ %s Selected%s\n\n", ColorGreen, info.Title, ColorReset)
 
-	return gen, network
-}
-
-// GetInputFromUser prompts user for prefix, suffix, and contains
-// Returns (prefix, suffix, contains) - contains is only supported for Bitcoin currently
-func GetInputFromUser(network generator.Network) (string, string, string) {
-	reader := bufio.NewReader(os.Stdin)
+	gen := newGenerator(info, useGPU)
 
-	fmt.Printf("    %s🎯 TARGET PATTERN%s\n", ColorPurple+ColorBold, ColorReset)
-
-	switch network {
-	case generator.Solana:
-		return getSolanaInput(reader)
-	case generator.Aptos, generator.Sui:
-		return getAptosInput(reader)
-	case generator.Bitcoin:
-		return getBitcoinInput(reader, SelectedBitcoinAddressType)
-	case generator.Tron:
-		return getTronInput(reader)
-	default:
-		return getEthereumInput(reader)
+	// Only networks with variants or options set these below
+	SelectedAddressType = generator.AddressTypeDefault
+	SelectedOptions = map[string]string{}
+	if setup, ok := networkSetup[info.Network]; ok {
+		setup(reader)
 	}
-}
 
-func getEthereumInput(reader *bufio.Reader) (string, string, string) {
-	fmt.Printf("    %sPrefix%s (0x...): ", ColorCyan, ColorReset)
-	prefixInput, _ := reader.ReadString('\n')
-	prefix := strings.TrimSpace(prefixInput)
-	prefix = strings.TrimPrefix(strings.ToLower(prefix), "0x")
+	return gen, info.Network
+}
 
-	if prefix != "" && !isValidHex(prefix) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		prefix = ""
+// newGenerator creates the network's GPU generator if requested and available,
+// falling back to the CPU generator.
+func newGenerator(info generator.NetworkInfo, useGPU bool) generator.Generator {
+	if useGPU && info.GPU != nil {
+		gpu, err := info.GPU()
+		if err == nil {
+			return gpu
+		}
+		fmt.Printf("    %s⚠ %s GPU failed: %v%s\n", ColorRed, info.Name, err, ColorReset)
+		fmt.Printf("    %s↪ Using CPU...%s\n", ColorYellow, ColorReset)
 	}
+	return cpu.NewCPUGenerator(0)
+}
 
-	fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-	containsInput, _ := reader.ReadString('\n')
-	contains := strings.TrimSpace(containsInput)
-	contains = strings.TrimPrefix(strings.ToLower(contains), "0x")
-
-	if contains != "" && !isValidHex(contains) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		contains = ""
+// NewConfig returns a configuration for the network with the options chosen
+// during network selection (address type, SS58 network, TON wallet, key owner...).
+func NewConfig(network generator.Network) *generator.Config {
+	return &generator.Config{
+		Network:     network,
+		AddressType: SelectedAddressType,         // Used by networks with variants (Bitcoin, Lightning, XRPL, Cardano, TON, SSH, OpenPGP, X25519)
+		Options:     maps.Clone(SelectedOptions), // Used by Substrate, Cardano base addresses, TON, SSH and OpenPGP
 	}
+}
 
-	fmt.Printf("    %sSuffix%s (...xxx): ", ColorCyan, ColorReset)
-	suffixInput, _ := reader.ReadString('\n')
-	suffix := strings.TrimSpace(suffixInput)
-	suffix = strings.ToLower(suffix)
-
-	if suffix != "" && !isValidHex(suffix) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		suffix = ""
-	}
+// GetInputFromUser prompts user for prefix, suffix, and contains.
+// Patterns are cleaned and validated with the network's pattern rules for the configuration.
+// Returns (prefix, suffix, contains); an invalid pattern is reported and left empty.
+func GetInputFromUser(config *generator.Config) (string, string, string) {
+	fmt.Printf("    %s🎯 TARGET PATTERN%s\n", ColorPurple+ColorBold, ColorReset)
+	return readPatterns(config)
+}
 
-	return prefix, suffix, contains
+// EditPattern prompts for a new prefix, suffix and contains while a search keeps running.
+// Returns them like GetInputFromUser; the caller swaps them into the search.
+func EditPattern(config *generator.Config) (string, string, string) {
+	fmt.Printf("\n    %s✎ CHANGE PATTERN%s %s(the search keeps running)%s\n", ColorPurple+ColorBold, ColorReset, ColorDim, ColorReset)
+	return readPatterns(config)
 }
 
-func getSolanaInput(reader *bufio.Reader) (string, string, string) {
-	fmt.Printf("    %sPrefix%s (...): ", ColorCyan, ColorReset)
-	prefixInput, _ := reader.ReadString('\n')
-	prefix := strings.TrimSpace(prefixInput)
+// readPatterns reads the prefix, contains and suffix prompts.
+func readPatterns(config *generator.Config) (string, string, string) {
+	reader := bufio.NewReader(stdin)
 
-	if prefix != "" && !solana.IsValidBase58(prefix) {
-		invalidChars := solana.InvalidBase58Chars(prefix)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		prefix = ""
+	info, ok := generator.Lookup(config.Network)
+	if !ok {
+		return "", "", ""
 	}
+	rules := info.Rules(config)
 
-	fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-	containsInput, _ := reader.ReadString('\n')
-	contains := strings.TrimSpace(containsInput)
-
-	if contains != "" && !solana.IsValidBase58(contains) {
-		invalidChars := solana.InvalidBase58Chars(contains)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		contains = ""
-	}
-
-	fmt.Printf("    %sSuffix%s (...): ", ColorCyan, ColorReset)
-	suffixInput, _ := reader.ReadString('\n')
-	suffix := strings.TrimSpace(suffixInput)
-
-	if suffix != "" && !solana.IsValidBase58(suffix) {
-		invalidChars := solana.InvalidBase58Chars(suffix)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		suffix = ""
+	prefixHint := "start"
+	if rules.Fixed != "" {
+		prefixHint = "after " + rules.Fixed
 	}
+	prefix := readPattern(reader, "Prefix", prefixHint, rules, rules.ValidatePrefix)
+	contains := readPattern(reader, "Contains", "middle", rules, rules.ValidateContains)
+	suffix := readPattern(reader, "Suffix", "...", rules, rules.ValidateSuffix)
 
 	return prefix, suffix, contains
 }
 
-func getAptosInput(reader *bufio.Reader) (string, string, string) {
-	fmt.Printf("    %sPrefix%s (0x...): ", ColorCyan, ColorReset)
-	prefixInput, _ := reader.ReadString('\n')
-	prefix := strings.TrimSpace(prefixInput)
-	prefix = strings.TrimPrefix(strings.ToLower(prefix), "0x")
-
-	if prefix != "" && !isValidHex(prefix) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		prefix = ""
+// readPattern prompts for one pattern and returns it cleaned, or empty if it is invalid.
+func readPattern(reader *bufio.Reader, label, hint string, rules generator.PatternRules, validate func(string) error) string {
+	fmt.Printf("    %s%s%s (%s): ", ColorCyan, label, ColorReset, hint)
+	if rules.Alphabet.CaseSensitive {
+		fmt.Printf("%s(Case-sensitive!)%s ", ColorYellow, ColorReset)
 	}
-
-	fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-	containsInput, _ := reader.ReadString('\n')
-	contains := strings.TrimSpace(containsInput)
-	contains = strings.TrimPrefix(strings.ToLower(contains), "0x")
-
-	if contains != "" && !isValidHex(contains) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		contains = ""
+	input, _ := reader.ReadString('\n')
+	pattern := rules.Clean(input)
+	if pattern == "" {
+		return ""
 	}
 
-	fmt.Printf("    %sSuffix%s (...xxx): ", ColorCyan, ColorReset)
-	suffixInput, _ := reader.ReadString('\n')
-	suffix := strings.TrimSpace(suffixInput)
-	suffix = strings.ToLower(suffix)
-
-	if suffix != "" && !isValidHex(suffix) {
-		fmt.Printf("    %s⚠ Invalid! Hex only (0-9, a-f)%s\n", ColorRed, ColorReset)
-		suffix = ""
+	if err := validate(pattern); err != nil {
+		msg, detail := err.Error(), ""
+		var patternErr *generator.PatternError
+		if errors.As(err, &patternErr) {
+			msg, detail = patternErr.Msg, patternErr.Hint
+		}
+		fmt.Printf("    %s⚠ Invalid %s: %s%s\n", ColorRed, strings.ToLower(label), msg, ColorReset)
+		if detail != "" {
+			fmt.Printf("    %s  (%s)%s\n", ColorDim, detail, ColorReset)
+		}
+		return ""
 	}
-
-	return prefix, suffix, contains
+	return pattern
 }
 
 // ContinueAction represents what the user wants to do after finding an address
@@ -336,7 +261,7 @@ const (
 
 // AskToContinue prompts user to continue, switch network, or exit
 func AskToContinue() ContinueAction {
-	reader := bufio.NewReader(os.Stdin)
+	reader := bufio.NewReader(stdin)
 	fmt.Printf("\n    %s[Enter]%s Continue  │  %s[N]%s New Network  │  %s[Q]%s Exit\n",
 		ColorGreen, ColorReset, ColorCyan, ColorReset, ColorRed, ColorReset)
 	fmt.Printf("    %s→%s ", ColorCyan, ColorReset)
@@ -353,16 +278,6 @@ func AskToContinue() ContinueAction {
 	}
 }
 
-// isValidHex checks if string contains only hex characters
-func isValidHex(s string) bool {
-	for _, c := range s {
-		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
-			return false
-		}
-	}
-	return true
-}
-
 // selectBitcoinAddressType prompts user to select a Bitcoin address type.
 func selectBitcoinAddressType(reader *bufio.Reader) generator.AddressType {
 	fmt.Printf("    %s🔧 SELECT ADDRESS TYPE%s\n", ColorPurple+ColorBold, ColorReset)
@@ -387,141 +302,258 @@ func selectBitcoinAddressType(reader *bufio.Reader) generator.AddressType {
 	}
 }
 
-// getBitcoinInput handles pattern input for Bitcoin addresses.
-// For Taproot: lowercase only (Bech32m)
-// For Legacy/SegWit: case-sensitive (Base58)
-// Returns (prefix, suffix, contains)
-func getBitcoinInput(reader *bufio.Reader, addrType generator.AddressType) (string, string, string) {
-	isBech32 := bitcoin.IsBech32Type(addrType)
-	prefix := bitcoin.AddressPrefix(addrType)
-
-	if isBech32 {
-		// Taproot - Bech32m (lowercase only)
-		fmt.Printf("    %sPrefix%s (after %s): ", ColorCyan, ColorReset, prefix)
-		prefixInput, _ := reader.ReadString('\n')
-		userPrefix := strings.TrimSpace(strings.ToLower(prefixInput))
-
-		if userPrefix != "" && !bitcoin.IsValidPattern(userPrefix, addrType) {
-			invalidChars := bitcoin.InvalidChars(userPrefix, addrType)
-			fmt.Printf("    %s⚠ Invalid Bech32 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 1, b, i, o)%s\n", ColorDim, ColorReset)
-			userPrefix = ""
-		}
+// selectLightningMode prompts user to select how the Lightning node key is generated.
+func selectLightningMode(reader *bufio.Reader) generator.AddressType {
+	fmt.Printf("    %s🔧 SELECT KEY MODE%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 📄 Core Lightning (hsm_secret) %s- Writes a ready-to-use hsm_secret%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 🔑 Node Key %s- Raw private key, for LND/Eclair/LDK%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
 
-		fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-		containsInput, _ := reader.ReadString('\n')
-		userContains := strings.TrimSpace(strings.ToLower(containsInput))
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
 
-		if userContains != "" && !bitcoin.IsValidPattern(userContains, addrType) {
-			invalidChars := bitcoin.InvalidChars(userContains, addrType)
-			fmt.Printf("    %s⚠ Invalid Bech32 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 1, b, i, o)%s\n", ColorDim, ColorReset)
-			userContains = ""
-		}
+	switch choice {
+	case "2":
+		fmt.Printf("    %s✓ Node Key Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeNodeKey
+	default:
+		fmt.Printf("    %s✓ Core Lightning (hsm_secret) Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeHSMSecret
+	}
+}
 
-		fmt.Printf("    %sSuffix%s (...): ", ColorCyan, ColorReset)
-		suffixInput, _ := reader.ReadString('\n')
-		userSuffix := strings.TrimSpace(strings.ToLower(suffixInput))
+// selectXRPLKeyType prompts user to select the XRPL account key type.
+func selectXRPLKeyType(reader *bufio.Reader) generator.AddressType {
+	fmt.Printf("    %s🔧 SELECT KEY TYPE%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🔑 secp256k1 (s...) %s- Default, supported everywhere%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 🔑 Ed25519 (sEd...) %s- Faster signing%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
 
-		if userSuffix != "" && !bitcoin.IsValidPattern(userSuffix, addrType) {
-			invalidChars := bitcoin.InvalidChars(userSuffix, addrType)
-			fmt.Printf("    %s⚠ Invalid Bech32 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 1, b, i, o)%s\n", ColorDim, ColorReset)
-			userSuffix = ""
-		}
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
 
-		return userPrefix, userSuffix, userContains
-	} else {
-		// Legacy/SegWit - Base58 (case-sensitive)
-		fmt.Printf("    %sPrefix%s (after %s): ", ColorCyan, ColorReset, prefix)
-		fmt.Printf("%s(Case-sensitive!)%s ", ColorYellow, ColorReset)
-		prefixInput, _ := reader.ReadString('\n')
-		userPrefix := strings.TrimSpace(prefixInput)
-
-		if userPrefix != "" && !bitcoin.IsValidPattern(userPrefix, addrType) {
-			invalidChars := bitcoin.InvalidChars(userPrefix, addrType)
-			fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-			userPrefix = ""
-		}
+	switch choice {
+	case "2":
+		fmt.Printf("    %s✓ Ed25519 Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeEd25519
+	default:
+		fmt.Printf("    %s✓ secp256k1 Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeSecp256k1
+	}
+}
 
-		fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-		fmt.Printf("%s(Case-sensitive!)%s ", ColorYellow, ColorReset)
-		containsInput, _ := reader.ReadString('\n')
-		userContains := strings.TrimSpace(containsInput)
-
-		if userContains != "" && !bitcoin.IsValidPattern(userContains, addrType) {
-			invalidChars := bitcoin.InvalidChars(userContains, addrType)
-			fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-			userContains = ""
-		}
+// selectSubstrateNetwork prompts user to select the SS58 network ID.
+func selectSubstrateNetwork(reader *bufio.Reader) uint16 {
+	fmt.Printf("    %s🔧 SELECT SS58 NETWORK%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s ● Polkadot (0) %s- Addresses start with 1%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s ● Kusama (2) %s- Addresses start with C-H%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+	fmt.Printf("    %s[3]%s ● Generic Substrate (42) %s- Addresses start with 5%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+	fmt.Printf("    %s[4]%s ✎ Custom network ID %s- 0-16383%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
 
-		fmt.Printf("    %sSuffix%s (...): ", ColorCyan, ColorReset)
-		suffixInput, _ := reader.ReadString('\n')
-		userSuffix := strings.TrimSpace(suffixInput)
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
 
-		if userSuffix != "" && !bitcoin.IsValidPattern(userSuffix, addrType) {
-			invalidChars := bitcoin.InvalidChars(userSuffix, addrType)
-			fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-			fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-			userSuffix = ""
+	switch choice {
+	case "2":
+		fmt.Printf("    %s✓ Kusama Selected%s\n\n", ColorGreen, ColorReset)
+		return substrate.KusamaID
+	case "3":
+		fmt.Printf("    %s✓ Generic Substrate Selected%s\n\n", ColorGreen, ColorReset)
+		return substrate.GenericID
+	case "4":
+		fmt.Printf("    %sNetwork ID%s: ", ColorCyan, ColorReset)
+		idInput, _ := reader.ReadString('\n')
+		id, err := strconv.ParseUint(strings.TrimSpace(idInput), 10, 16)
+		if err != nil || !substrate.IsValidNetworkID(uint16(id)) {
+			fmt.Printf("    %s⚠ Invalid network ID! Using generic Substrate (42)%s\n\n", ColorRed, ColorReset)
+			return substrate.GenericID
 		}
+		fmt.Printf("    %s✓ Network ID %d Selected%s\n\n", ColorGreen, id, ColorReset)
+		return uint16(id)
+	default:
+		fmt.Printf("    %s✓ Polkadot Selected%s\n\n", ColorGreen, ColorReset)
+		return substrate.PolkadotID
+	}
+}
 
-		return userPrefix, userSuffix, userContains
+// selectCardanoAddressType prompts user to select the Cardano address type.
+// Base addresses also need the stake key hash that every candidate delegates to.
+func selectCardanoAddressType(reader *bufio.Reader) (generator.AddressType, string) {
+	fmt.Printf("    %s🔧 SELECT ADDRESS TYPE%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🔑 Enterprise (addr1v...) %s- Payment key only, no staking%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 🔗 Base (addr1q...) %s- Payment key + your existing stake key%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	if choice != "2" {
+		fmt.Printf("    %s✓ Enterprise Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeEnterprise, ""
+	}
+
+	fmt.Printf("    %sStake key%s (stake1u... or 56-char hash): ", ColorCyan, ColorReset)
+	stakeInput, _ := reader.ReadString('\n')
+	stakeKey := strings.TrimSpace(stakeInput)
+	if _, err := cardano.ParseStakeKeyHash(stakeKey); err != nil {
+		fmt.Printf("    %s⚠ Invalid stake key: %v%s\n", ColorRed, err, ColorReset)
+		fmt.Printf("    %s↪ Using Enterprise...%s\n\n", ColorYellow, ColorReset)
+		return generator.AddressTypeEnterprise, ""
 	}
+
+	fmt.Printf("    %s✓ Base Selected%s\n\n", ColorGreen, ColorReset)
+	return generator.AddressTypeBase, stakeKey
 }
 
-// getTronInput handles pattern input for Tron addresses.
-// Tron addresses use Base58 encoding and always start with 'T'.
-func getTronInput(reader *bufio.Reader) (string, string, string) {
-	fmt.Printf("    %sPrefix%s (after T): ", ColorCyan, ColorReset)
-	fmt.Printf("%s(Case-sensitive!)%s ", ColorYellow, ColorReset)
-	prefixInput, _ := reader.ReadString('\n')
-	prefix := strings.TrimSpace(prefixInput)
-
-	// Special validation for Tron: first character after 'T' must be uppercase A-Z
-	if prefix != "" {
-		firstChar := prefix[0]
-		isDigit := firstChar >= '0' && firstChar <= '9' // Check for any digit
-		isLower := firstChar >= 'a' && firstChar <= 'z'
-
-		if isDigit || isLower {
-			fmt.Printf("    %s⚠ Invalid prefix! First character after 'T' MUST be an UPPERCASE letter (A-Z)%s\n", ColorRed, ColorReset)
-			fmt.Printf("    %s  (Digits and lowercase letters are not possible at this position in Tron addresses)%s\n", ColorDim, ColorReset)
-			prefix = ""
-		}
+// selectTONWallet prompts user to select the TON wallet version, workchain and address form.
+func selectTONWallet(reader *bufio.Reader) (generator.AddressType, int8, bool) {
+	fmt.Printf("    %s🔧 SELECT WALLET VERSION%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 👛 Wallet v4R2 %s- Most widely supported%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 👛 Wallet v5R1 (W5) %s- Tonkeeper default%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	addrType := generator.AddressTypeWalletV4R2
+	if choice == "2" {
+		addrType = generator.AddressTypeWalletV5R1
+	}
+	fmt.Printf("    %s✓ %s Selected%s\n\n", ColorGreen, addrType, ColorReset)
+
+	fmt.Printf("    %s🔧 SELECT WORKCHAIN%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s ⛓ Basechain (0) %s- Regular wallets%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s ⛓ Masterchain (-1) %s- Validators, higher fees%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ = reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	workchain := ton.BaseWorkchain
+	if choice == "2" {
+		workchain = ton.MasterWorkchain
+		fmt.Printf("    %s✓ Masterchain Selected%s\n\n", ColorGreen, ColorReset)
+	} else {
+		fmt.Printf("    %s✓ Basechain Selected%s\n\n", ColorGreen, ColorReset)
+	}
+
+	fmt.Printf("    %s🔧 SELECT ADDRESS FORM%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🔗 Non-bounceable (%s...) %s- Shown by wallets for user accounts%s\n", ColorCyan, ColorReset, ton.AddressPrefix(workchain, false), ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 🔗 Bounceable (%s...) %s- Used for deployed contracts%s\n", ColorCyan, ColorReset, ton.AddressPrefix(workchain, true), ColorDim, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ = reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	bounceable := choice == "2"
+	if bounceable {
+		fmt.Printf("    %s✓ Bounceable Selected%s\n\n", ColorGreen, ColorReset)
+	} else {
+		fmt.Printf("    %s✓ Non-bounceable Selected%s\n\n", ColorGreen, ColorReset)
 	}
 
-	if prefix != "" && !tron.IsValidBase58(prefix) {
-		invalidChars := tron.InvalidBase58Chars(prefix)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		prefix = ""
+	return addrType, workchain, bounceable
+}
+
+// selectSSHMode prompts user to select what to match (fingerprint or public key text)
+// and the comment to store in the key files (defaults to user@host, like ssh-keygen).
+func selectSSHMode(reader *bufio.Reader) (generator.AddressType, string) {
+	fmt.Printf("    %s🔧 SELECT MATCH TARGET%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🔎 Fingerprint %s- %s... as shown by ssh-keygen -l%s\n", ColorCyan, ColorReset, ColorGreen, openssh.FingerprintPrefix, ColorReset)
+	fmt.Printf("    %s[2]%s 📜 Public Key %s- %s AAAA... as in authorized_keys%s\n", ColorCyan, ColorReset, ColorDim, openssh.KeyType, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	addrType := generator.AddressTypeFingerprint
+	switch choice {
+	case "2":
+		addrType = generator.AddressTypePublicKey
+		fmt.Printf("    %s✓ Public Key Selected%s\n\n", ColorGreen, ColorReset)
+	default:
+		fmt.Printf("    %s✓ Fingerprint Selected%s\n\n", ColorGreen, ColorReset)
+	}
+
+	defaultComment := defaultKeyOwner()
+	fmt.Printf("    %sKey comment%s %s(Enter for %q)%s: ", ColorCyan, ColorReset, ColorDim, defaultComment, ColorReset)
+	commentInput, _ := reader.ReadString('\n')
+	comment := strings.TrimSpace(commentInput)
+	if comment == "" {
+		comment = defaultComment
 	}
+	fmt.Println()
+
+	return addrType, comment
+}
+
+// defaultKeyOwner returns user@host for key comments and user IDs, like ssh-keygen.
+func defaultKeyOwner() string {
+	owner := os.Getenv("USER")
+	if host, err := os.Hostname(); err == nil && owner != "" {
+		owner += "@" + host
+	}
+	return owner
+}
+
+// selectPGPMode prompts user to select what to match (fingerprint or long key ID),
+// the user ID for the self-signed key and how far back the creation time may go.
+func selectPGPMode(reader *bufio.Reader) (generator.AddressType, string, time.Duration) {
+	fmt.Printf("    %s🔧 SELECT MATCH TARGET%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🔎 Fingerprint %s- 40 hex chars, as shown by gpg -K%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 🆔 Long Key ID %s- last 16 hex chars of the fingerprint%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
 
-	fmt.Printf("    %sContains%s (middle): ", ColorCyan, ColorReset)
-	fmt.Printf("%s(Case-sensitive!)%s ", ColorYellow, ColorReset)
-	containsInput, _ := reader.ReadString('\n')
-	contains := strings.TrimSpace(containsInput)
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
 
-	if contains != "" && !tron.IsValidBase58(contains) {
-		invalidChars := tron.InvalidBase58Chars(contains)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		contains = ""
+	addrType := generator.AddressTypeFingerprint
+	switch choice {
+	case "2":
+		addrType = generator.AddressTypeKeyID
+		fmt.Printf("    %s✓ Long Key ID Selected%s\n\n", ColorGreen, ColorReset)
+	default:
+		fmt.Printf("    %s✓ Fingerprint Selected%s\n\n", ColorGreen, ColorReset)
 	}
 
-	fmt.Printf("    %sSuffix%s (...): ", ColorCyan, ColorReset)
-	suffixInput, _ := reader.ReadString('\n')
-	suffix := strings.TrimSpace(suffixInput)
+	defaultUserID := defaultKeyOwner()
+	fmt.Printf("    %sUser ID%s %s(e.g. Alice <alice@example.com>, Enter for %q)%s: ", ColorCyan, ColorReset, ColorDim, defaultUserID, ColorReset)
+	userIDInput, _ := reader.ReadString('\n')
+	userID := strings.TrimSpace(userIDInput)
+	if userID == "" {
+		userID = defaultUserID
+	}
 
-	if suffix != "" && !tron.IsValidBase58(suffix) {
-		invalidChars := tron.InvalidBase58Chars(suffix)
-		fmt.Printf("    %s⚠ Invalid Base58 character(s): %s%s\n", ColorRed, string(invalidChars), ColorReset)
-		fmt.Printf("    %s  (Not allowed: 0, O, I, l)%s\n", ColorDim, ColorReset)
-		suffix = ""
+	// Every second in the window is one fingerprint to try per key pair
+	defaultDays := openpgp.DefaultTimeWindow / (24 * 60 * 60)
+	fmt.Printf("    %sCreation time window%s %s(days back from now, Enter for %d)%s: ", ColorCyan, ColorReset, ColorDim, defaultDays, ColorReset)
+	windowInput, _ := reader.ReadString('\n')
+	days, err := strconv.Atoi(strings.TrimSpace(windowInput))
+	if err != nil || days <= 0 {
+		days = defaultDays
 	}
+	fmt.Println()
 
-	return prefix, suffix, contains
+	return addrType, userID, time.Duration(days) * 24 * time.Hour
+}
+
+// selectX25519Format prompts user to select the X25519 key format to match.
+func selectX25519Format(reader *bufio.Reader) generator.AddressType {
+	fmt.Printf("    %s🔧 SELECT KEY FORMAT%s\n", ColorPurple+ColorBold, ColorReset)
+	fmt.Printf("    %s[1]%s 🛡 WireGuard %s- Base64 public key, writes wg0.conf%s\n", ColorCyan, ColorReset, ColorGreen, ColorReset)
+	fmt.Printf("    %s[2]%s 📦 age %s- age1... recipient, writes key.txt%s\n", ColorCyan, ColorReset, ColorDim, ColorReset)
+
+	fmt.Printf("\n    %s→%s ", ColorGreen, ColorReset)
+	choice, _ := reader.ReadString('\n')
+	choice = strings.TrimSpace(choice)
+
+	switch choice {
+	case "2":
+		fmt.Printf("    %s✓ age Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeAge
+	default:
+		fmt.Printf("    %s✓ WireGuard Selected%s\n\n", ColorGreen, ColorReset)
+		return generator.AddressTypeWireGuard
+	}
 }
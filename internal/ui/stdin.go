@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sync"
+)
+
+// stdin is the standard input shared by every prompt and by the search loop.
+// Prompts wrap it in a bufio.Reader as before; the search loop reads InputLines.
+var stdin = &lineReader{src: os.Stdin}
+
+// lineReader reads standard input one line at a time in a background goroutine,
+// so a search can watch for keys and the next prompt still gets the following line.
+type lineReader struct {
+	src     io.Reader
+	once    sync.Once
+	lines   chan string
+	pending string // Rest of the line a prompt is reading
+}
+
+// start begins reading lines. It runs once, on first use.
+func (r *lineReader) start() {
+	r.lines = make(chan string)
+	go func() {
+		reader := bufio.NewReader(r.src)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				r.lines <- line
+			}
+			if err != nil {
+				close(r.lines)
+				return
+			}
+		}
+	}()
+}
+
+// Read returns at most one line per call, so a prompt's bufio.Reader never
+// buffers input beyond the line it asked for.
+func (r *lineReader) Read(p []byte) (int, error) {
+	r.once.Do(r.start)
+	if r.pending == "" {
+		line, ok := <-r.lines
+		if !ok {
+			return 0, io.EOF
+		}
+		r.pending = line
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// InputLines returns the lines typed while no prompt is reading, e.g. Enter during a search.
+func InputLines() <-chan string {
+	stdin.once.Do(stdin.start)
+	return stdin.lines
+}
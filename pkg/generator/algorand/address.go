@@ -0,0 +1,31 @@
+// Package algorand provides Algorand vanity address generation support.
+// Algorand addresses are Base32(pubkey || checksum), 58 characters,
+// where checksum is the last 4 bytes of SHA-512/256(pubkey).
+package algorand
+
+import (
+	"crypto/sha512"
+	"encoding/base32"
+)
+
+const (
+	// AddressLen is the length of an encoded Algorand address.
+	AddressLen = 58
+	// checksumLen is the number of SHA-512/256 bytes appended to the public key.
+	checksumLen = 4
+)
+
+// addressEncoding is RFC 4648 Base32 without padding, as used by Algorand.
+var addressEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// DeriveAddress encodes an Ed25519 public key as an Algorand address.
+// Address = Base32(pubkey || SHA-512/256(pubkey)[28:32])
+func DeriveAddress(pubKey []byte) string {
+	hash := sha512.Sum512_256(pubKey)
+
+	data := make([]byte, 0, len(pubKey)+checksumLen)
+	data = append(data, pubKey...)
+	data = append(data, hash[len(hash)-checksumLen:]...)
+
+	return addressEncoding.EncodeToString(data)
+}
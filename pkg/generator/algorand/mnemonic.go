@@ -0,0 +1,56 @@
+package algorand
+
+import (
+	"crypto/sha512"
+	_ "embed"
+	"strings"
+)
+
+//go:embed wordlist/english.txt
+var englishWordlist string
+
+// wordlist is the BIP-39 English wordlist (2048 words), which Algorand reuses.
+var wordlist = strings.Split(strings.TrimSpace(englishWordlist), "\n")
+
+// SeedToMnemonic converts a 32-byte Ed25519 seed to Algorand's 25-word mnemonic.
+// The seed is split into 24 little-endian 11-bit words; the 25th word is the
+// first 11 bits of SHA-512/256(seed). This is not BIP-39, only its wordlist.
+func SeedToMnemonic(seed []byte) string {
+	words := toWords(toUint11(seed))
+
+	hash := sha512.Sum512_256(seed)
+	checksumWord := toWords(toUint11(hash[:2]))[0]
+
+	return strings.Join(words, " ") + " " + checksumWord
+}
+
+// toUint11 splits bytes into 11-bit values, least significant bits first.
+func toUint11(data []byte) []uint32 {
+	var buffer uint32
+	var bits uint32
+	var out []uint32
+
+	for _, b := range data {
+		buffer |= uint32(b) << bits
+		bits += 8
+		if bits >= 11 {
+			out = append(out, buffer&2047)
+			buffer >>= 11
+			bits -= 11
+		}
+	}
+	if bits != 0 {
+		out = append(out, buffer&2047)
+	}
+
+	return out
+}
+
+// toWords maps 11-bit values to wordlist entries.
+func toWords(values []uint32) []string {
+	words := make([]string, len(values))
+	for i, v := range values {
+		words[i] = wordlist[v]
+	}
+	return words
+}
@@ -0,0 +1,57 @@
+package algorand
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Algorand,
+		Name:        "Algorand",
+		Title:       "Algorand (ALGO)",
+		Icon:        "Ⓐ",
+		Description: "Base32, 25-word mnemonic",
+		ResultLabel: "ALGORAND ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The last character only carries 3 bits
+			return generator.PatternRules{
+				Alphabet:    generator.Base32,
+				SuffixChars: []string{LastCharRange},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Algorand addresses (Ed25519 + SHA-512/256 checksum + Base32)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Algorand address = Base32(pubkey || SHA-512/256(pubkey)[28:32])
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address)
+		if !ok {
+			return 1, nil
+		}
+		// Wallets import the 25-word mnemonic, not the raw seed
+		return 1, &generator.Result{
+			Network:    generator.Algorand,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: SeedToMnemonic(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex seed)", Value: hex.EncodeToString(privKey.Seed())},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,5 @@
+package algorand
+
+// LastCharRange lists the characters possible at the end of an address.
+// 36 bytes = 288 bits, so the 58th character carries only 3 bits (plus 2 zero padding bits).
+const LastCharRange = "AEIMQUY4"
@@ -0,0 +1,20 @@
+package aptos
+
+import (
+	"encoding/hex"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// DeriveAddress derives an Aptos address from an Ed25519 public key.
+// Formula: SHA3-256(pubkey || 0x00)
+// The 0x00 is the single-signature scheme identifier.
+func DeriveAddress(pubKey []byte) string {
+	// Aptos: SHA3-256(pubkey || 0x00)
+	data := make([]byte, len(pubKey)+1)
+	copy(data, pubKey)
+	data[len(pubKey)] = 0x00 // Single-signature scheme identifier
+
+	hash := sha3.Sum256(data)
+	return "0x" + hex.EncodeToString(hash[:])
+}
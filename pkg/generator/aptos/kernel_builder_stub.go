@@ -3,12 +3,5 @@
 
 package aptos
 
-// LoadAptosKernel is a stub for non-OpenCL builds.
-func LoadAptosKernel() (string, error) {
-	return "", nil
-}
-
-// GetAptosKernelName is a stub for non-OpenCL builds.
-func GetAptosKernelName() string {
-	return ""
-}
+// deriveKernel is empty for non-OpenCL builds, whose GPU engine is a stub.
+const deriveKernel = ""
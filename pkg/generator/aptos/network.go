@@ -0,0 +1,52 @@
+package aptos
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Aptos,
+		Name:        "Aptos",
+		Title:       "Aptos (APT)",
+		Icon:        "◆",
+		Description: "0x prefix, Hex",
+		ResultLabel: "APTOS ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.HexRules("0x")
+		},
+		NewWorker: newWorker,
+		GPU:       newGPUGenerator,
+	})
+}
+
+// newWorker returns a CPU search step for Aptos addresses (Ed25519 + SHA3-256)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Aptos address = SHA3-256(pubkey || 0x00)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(strings.TrimPrefix(address, "0x"))
+		if !ok {
+			return 1, nil
+		}
+		// Return the seed (first 32 bytes of privKey) as hex
+		return 1, &generator.Result{
+			Network:    generator.Aptos,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+		}
+	}, nil
+}
@@ -103,7 +103,7 @@ func taprootTweak(pubKeyX []byte, merkleRoot []byte) []byte {
 func deriveLegacyAddress(pubKey *btcec.PublicKey) string {
 	// HASH160 = RIPEMD160(SHA256(compressed_pubkey))
 	pubKeyBytes := pubKey.SerializeCompressed()
-	hash160 := hash160(pubKeyBytes)
+	hash160 := Hash160(pubKeyBytes)
 
 	// Version byte 0x00 for mainnet P2PKH
 	data := make([]byte, 21)
@@ -119,7 +119,7 @@ func deriveLegacyAddress(pubKey *btcec.PublicKey) string {
 func deriveNestedSegWitAddress(pubKey *btcec.PublicKey) string {
 	// First, get HASH160 of the compressed public key
 	pubKeyBytes := pubKey.SerializeCompressed()
-	pubKeyHash := hash160(pubKeyBytes)
+	pubKeyHash := Hash160(pubKeyBytes)
 
 	// Create the P2WPKH witness program: OP_0 (0x00) + push 20 bytes (0x14) + pubkeyhash
 	witnessProgram := make([]byte, 22)
@@ -128,7 +128,7 @@ func deriveNestedSegWitAddress(pubKey *btcec.PublicKey) string {
 	copy(witnessProgram[2:], pubKeyHash)
 
 	// HASH160 of the witness program
-	scriptHash := hash160(witnessProgram)
+	scriptHash := Hash160(witnessProgram)
 
 	// Version byte 0x05 for mainnet P2SH
 	data := make([]byte, 21)
@@ -138,29 +138,42 @@ func deriveNestedSegWitAddress(pubKey *btcec.PublicKey) string {
 	return Base58CheckEncode(data)
 }
 
-// hash160 computes RIPEMD160(SHA256(data))
-func hash160(data []byte) []byte {
+// Hash160 computes RIPEMD160(SHA256(data))
+func Hash160(data []byte) []byte {
 	sha := sha256.Sum256(data)
 	ripemd := ripemd160.New()
 	ripemd.Write(sha[:])
 	return ripemd.Sum(nil)
 }
 
+// Base58 alphabets. Both exclude 0, O, I, l but order the symbols differently.
+const (
+	BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	RippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
 // Base58CheckEncode encodes data with a 4-byte checksum in Base58.
 func Base58CheckEncode(data []byte) string {
+	return Base58CheckEncodeAlphabet(data, BitcoinAlphabet)
+}
+
+// Base58CheckEncodeAlphabet encodes data with a 4-byte checksum using the given Base58 alphabet.
+// Used by XRPL, which shares Bitcoin's Base58Check but with the Ripple alphabet.
+func Base58CheckEncodeAlphabet(data []byte, alphabet string) string {
 	// Double SHA256 for checksum
 	first := sha256.Sum256(data)
 	second := sha256.Sum256(first[:])
 
 	// Append first 4 bytes of second hash as checksum
-	full := append(data, second[:4]...)
+	full := make([]byte, 0, len(data)+4)
+	full = append(full, data...)
+	full = append(full, second[:4]...)
 
-	return base58Encode(full)
+	return base58Encode(full, alphabet)
 }
 
-// base58Encode encodes bytes to Base58 string.
-func base58Encode(data []byte) string {
-	const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+// base58Encode encodes bytes to a Base58 string using the given alphabet.
+func base58Encode(data []byte, alphabet string) string {
 
 	// Count leading zeros
 	zeros := 0
@@ -192,7 +205,7 @@ func base58Encode(data []byte) string {
 	// Build the result string
 	result := make([]byte, zeros+size-i)
 	for j := 0; j < zeros; j++ {
-		result[j] = '1' // Leading zeros become '1' in Base58
+		result[j] = alphabet[0] // Leading zeros become the zero symbol ('1' for Bitcoin, 'r' for XRPL)
 	}
 	for j := zeros; i < size; i, j = i+1, j+1 {
 		result[j] = alphabet[buf[i]]
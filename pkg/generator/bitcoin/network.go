@@ -0,0 +1,66 @@
+package bitcoin
+
+import (
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.Bitcoin,
+		Name:         "Bitcoin",
+		Title:        "Bitcoin (BTC)",
+		Icon:         "₿",
+		Description:  "Taproot/Legacy/SegWit",
+		ResultLabel:  "BITCOIN ADDRESS",
+		KeyType:      generator.KeySecp256k1,
+		AddressTypes: []generator.AddressType{generator.AddressTypeTaproot, generator.AddressTypeLegacy, generator.AddressTypeNestedSegWit},
+		Rules: func(config *generator.Config) generator.PatternRules {
+			addrType := addressType(config)
+			// Taproot is Bech32m (lowercase), Legacy/SegWit Base58 (case-sensitive)
+			alphabet := generator.Base58
+			if IsBech32Type(addrType) {
+				alphabet = generator.Bech32
+			}
+			return generator.PatternRules{Alphabet: alphabet, Fixed: AddressPrefix(addrType)}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// addressType returns the configured address type (default to Taproot).
+func addressType(config *generator.Config) generator.AddressType {
+	if config.AddressType == generator.AddressTypeDefault {
+		return generator.AddressTypeTaproot
+	}
+	return config.AddressType
+}
+
+// newWorker returns a CPU search step for Bitcoin addresses (secp256k1 + SHA256/RIPEMD160 or Schnorr)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	addrType := addressType(config)
+	// Patterns match after the fixed bc1p / 1 / 3
+	skip := len(AddressPrefix(addrType))
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		// Generate secp256k1 key pair
+		privKey, pubKey, err := GenerateKeyPair()
+		if err != nil {
+			return 0, nil
+		}
+
+		// Derive address based on type
+		address := DeriveAddress(pubKey, addrType)
+
+		partial, ok := pattern.Check(address[skip:])
+		if !ok {
+			return 1, nil
+		}
+		// Convert private key to WIF format
+		return 1, &generator.Result{
+			Network:    generator.Bitcoin,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: PrivateKeyToWIF(privKey),
+		}
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Package cardano provides Cardano Shelley vanity address generation support.
+// Address = Bech32("addr", header || Blake2b-224(payment key) [|| stake key hash]).
+package cardano
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// AddressHRP is the Bech32 human-readable part for mainnet payment addresses.
+	AddressHRP = "addr"
+	// StakeHRP is the Bech32 human-readable part for mainnet stake addresses.
+	StakeHRP = "stake"
+	// SigningKeyHRP is the Bech32 human-readable part for Ed25519 payment signing keys (CIP-5).
+	SigningKeyHRP = "addr_sk"
+	// AddressPrefix is the fixed leading part of every mainnet address (HRP + separator).
+	AddressPrefix = AddressHRP + "1"
+
+	// KeyHashSize is the size of a Blake2b-224 key hash.
+	KeyHashSize = 28
+)
+
+// Shelley header bytes (address type in the high nibble, network ID 1 = mainnet in the low nibble).
+const (
+	HeaderBase       byte = 0x01 // Type 0: payment key hash + stake key hash
+	HeaderEnterprise byte = 0x61 // Type 6: payment key hash only
+	HeaderStake      byte = 0xE1 // Type 14: stake key hash (reward account)
+)
+
+// KeyHash returns the Blake2b-224 hash of an Ed25519 public key.
+func KeyHash(pubKey []byte) []byte {
+	h, _ := blake2b.New(KeyHashSize, nil)
+	h.Write(pubKey)
+	return h.Sum(nil)
+}
+
+// DeriveEnterpriseAddress derives an enterprise (payment-only) address.
+func DeriveEnterpriseAddress(pubKey []byte) string {
+	data := make([]byte, 0, 1+KeyHashSize)
+	data = append(data, HeaderEnterprise)
+	data = append(data, KeyHash(pubKey)...)
+	return encodeBech32(AddressHRP, data)
+}
+
+// DeriveBaseAddress derives a base address delegating to the given stake key hash.
+func DeriveBaseAddress(pubKey, stakeKeyHash []byte) string {
+	data := make([]byte, 0, 1+2*KeyHashSize)
+	data = append(data, HeaderBase)
+	data = append(data, KeyHash(pubKey)...)
+	data = append(data, stakeKeyHash...)
+	return encodeBech32(AddressHRP, data)
+}
+
+// ParseStakeKeyHash accepts a stake key hash as 56 hex characters or a
+// key-based mainnet stake address (stake1u...) and returns the 28-byte hash.
+func ParseStakeKeyHash(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(strings.ToLower(s), StakeHRP+"1") {
+		hrp, data, err := bech32.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+		if hrp != StakeHRP {
+			return nil, fmt.Errorf("not a mainnet stake address")
+		}
+		raw, err := bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(raw) != 1+KeyHashSize || raw[0] != HeaderStake {
+			return nil, fmt.Errorf("not a key-based stake address")
+		}
+		return raw[1:], nil
+	}
+
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("stake key hash must be hex or a stake1... address")
+	}
+	if len(raw) != KeyHashSize {
+		return nil, fmt.Errorf("stake key hash must be 28 bytes (56 hex characters)")
+	}
+	return raw, nil
+}
+
+// SeedToBech32 encodes a 32-byte Ed25519 seed as an addr_sk1... signing key.
+func SeedToBech32(seed []byte) string {
+	return encodeBech32(SigningKeyHRP, seed)
+}
+
+// encodeBech32 encodes data under the given HRP.
+// Shelley addresses use classic Bech32 and may exceed the BIP-173 90-character limit,
+// which only applies to decoding.
+func encodeBech32(hrp string, data []byte) string {
+	conv, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return ""
+	}
+	encoded, err := bech32.Encode(hrp, conv)
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
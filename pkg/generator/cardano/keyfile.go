@@ -0,0 +1,40 @@
+package cardano
+
+import (
+	"encoding/hex"
+	"encoding/json"
+)
+
+// Key file names written next to the result, as produced by `cardano-cli address key-gen`.
+const (
+	SigningKeyFile      = "payment.skey"
+	VerificationKeyFile = "payment.vkey"
+)
+
+// textEnvelope is the cardano-cli text envelope JSON format.
+type textEnvelope struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	CborHex     string `json:"cborHex"`
+}
+
+// SigningKeyEnvelope returns a cardano-cli payment.skey for a 32-byte Ed25519 seed.
+func SigningKeyEnvelope(seed []byte) []byte {
+	return envelope("PaymentSigningKeyShelley_ed25519", "Payment Signing Key", seed)
+}
+
+// VerificationKeyEnvelope returns a cardano-cli payment.vkey for an Ed25519 public key.
+func VerificationKeyEnvelope(pubKey []byte) []byte {
+	return envelope("PaymentVerificationKeyShelley_ed25519", "Payment Verification Key", pubKey)
+}
+
+// envelope wraps 32 bytes of key material as a CBOR byte string (0x58 0x20 prefix).
+func envelope(keyType, description string, key []byte) []byte {
+	cbor := append([]byte{0x58, byte(len(key))}, key...)
+	data, _ := json.MarshalIndent(textEnvelope{
+		Type:        keyType,
+		Description: description,
+		CborHex:     hex.EncodeToString(cbor),
+	}, "", "    ")
+	return append(data, '\n')
+}
@@ -0,0 +1,118 @@
+package cardano
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.Cardano,
+		Name:         "Cardano",
+		Title:        "Cardano (ADA)",
+		Icon:         "₳",
+		Description:  "Bech32, addr1 prefix",
+		ResultLabel:  "CARDANO ADDRESS",
+		KeyType:      generator.KeyEd25519,
+		AddressTypes: []generator.AddressType{generator.AddressTypeEnterprise, generator.AddressTypeBase},
+		Rules: func(config *generator.Config) generator.PatternRules {
+			isBase := config.AddressType == generator.AddressTypeBase
+			header := HeaderEnterprise
+			if isBase {
+				header = HeaderBase
+			}
+			// The header byte leaves only 4 possible values for the first character
+			rules := generator.PatternRules{
+				Alphabet:    generator.Bech32,
+				Fixed:       AddressPrefix + HeaderChar(header),
+				PrefixChars: []string{SecondCharRange},
+			}
+			if !isBase {
+				// Padding bits limit the last character before the checksum
+				rules.SuffixChars = EnterpriseSuffixChars
+			}
+			if isBase {
+				// The stake part is fixed, so contains only searches the payment part
+				rules.ContainsEnd = paymentChars
+				rules.CheckSuffix = func(suffix string) error {
+					if len(suffix) > MaxBaseSuffixLen {
+						return &generator.PatternError{
+							Msg:  "suffix too long, base addresses end with the fixed stake key hash",
+							Hint: fmt.Sprintf("Only the last %d characters can vary", MaxBaseSuffixLen),
+						}
+					}
+					return nil
+				}
+			}
+			return rules
+		},
+		NewWorker: newWorker,
+		Options: []generator.Option{{
+			Name:        OptionStakeKey,
+			Description: "stake key hash of base addresses (stake1u... or 56 hex characters)",
+			Validate: func(value string) error {
+				_, err := ParseStakeKeyHash(value)
+				return err
+			},
+		}},
+	})
+}
+
+// OptionStakeKey is the Config.Options key of the stake key hash that base addresses delegate to.
+const OptionStakeKey = "stake-key"
+
+// newWorker returns a CPU search step for Cardano Shelley addresses (Ed25519 + Blake2b-224 + Bech32)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine address type (default to enterprise)
+	isBase := config.AddressType == generator.AddressTypeBase
+	var stakeKey []byte
+	if isBase {
+		if config.Option(OptionStakeKey) == "" {
+			return nil, fmt.Errorf("base address requires the %s option", OptionStakeKey)
+		}
+		var err error
+		if stakeKey, err = ParseStakeKeyHash(config.Option(OptionStakeKey)); err != nil {
+			return nil, err
+		}
+	}
+	// Patterns match after addr1 + header character
+	skip := len(AddressPrefix) + 1
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Address = Bech32("addr", header || Blake2b-224(pubkey) [|| stake key hash])
+		var address string
+		if isBase {
+			address = DeriveBaseAddress(pubKey, stakeKey)
+		} else {
+			address = DeriveEnterpriseAddress(pubKey)
+		}
+
+		partial, ok := pattern.Check(address[skip:])
+		if !ok {
+			return 1, nil
+		}
+		seed := privKey.Seed()
+		return 1, &generator.Result{
+			Network:    generator.Cardano,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: SeedToBech32(seed),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex)", Value: hex.EncodeToString(seed)},
+			},
+			Files: []generator.KeyFile{
+				{Name: SigningKeyFile, Data: SigningKeyEnvelope(seed)},
+				{Name: VerificationKeyFile, Data: VerificationKeyEnvelope(pubKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,49 @@
+package cardano
+
+import "github.com/Amr-9/HexHunter/pkg/generator"
+
+// SecondCharRange lists the characters possible right after the header character.
+// Both mainnet headers (0x61, 0x01) end in bits 001, leaving only 2 free bits.
+const SecondCharRange = "y9x8"
+
+// MaxBaseSuffixLen is the longest suffix that can vary in a base address:
+// everything before the 6-character checksum comes from the fixed stake key hash.
+const MaxBaseSuffixLen = 6
+
+// paymentChars is the number of characters after the header character that
+// depend only on the payment key hash (header + 28 bytes = 232 bits, 46 full characters).
+const paymentChars = 46
+
+// checksumChars is the length of the Bech32 checksum at the end of every address.
+const checksumChars = 6
+
+// EnterpriseSuffixChars lists the characters possible at each position of an enterprise
+// address suffix, counting back from the last one (see generator.PatternRules.SuffixChars).
+// The checksum can be anything; the last data character before it holds the last 2 bits
+// of the key hash (header + 28 bytes = 232 bits) and 3 zero padding bits.
+var EnterpriseSuffixChars = enterpriseSuffixChars()
+
+func enterpriseSuffixChars() []string {
+	chars := make([]string, checksumChars+1)
+	for i := range checksumChars {
+		chars[i] = generator.Bech32.Chars
+	}
+	chars[checksumChars] = paddingCharRange((1 + KeyHashSize) * 8 % 5)
+	return chars
+}
+
+// paddingCharRange returns the characters whose bits after the first dataBits are zero padding.
+func paddingCharRange(dataBits int) string {
+	var allowed []byte
+	for v := 0; v < len(generator.Bech32.Chars); v++ {
+		if v&(1<<(5-dataBits)-1) == 0 {
+			allowed = append(allowed, generator.Bech32.Chars[v])
+		}
+	}
+	return string(allowed)
+}
+
+// HeaderChar returns the character every address with the given header starts with after "addr1".
+func HeaderChar(header byte) string {
+	return string(generator.Bech32.Chars[header>>3])
+}
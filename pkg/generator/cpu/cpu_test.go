@@ -0,0 +1,177 @@
+package cpu
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/nostr"
+)
+
+// Nostr is cheap to search and its Bech32 alphabet makes difficulty easy to pick:
+// each character is 1 in 32.
+var (
+	easyConfig = generator.Config{Network: generator.Nostr, Prefix: "q"}
+	hardConfig = generator.Config{Network: generator.Nostr, Prefix: "qqqqqqqqqq"}
+)
+
+// drain reads events until the stream is closed and returns the terminal event.
+func drain(t *testing.T, events <-chan generator.Event) generator.Event {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	var last generator.Event
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				if !last.Terminal() {
+					t.Fatalf("stream closed after a %s event", last.Type)
+				}
+				return last
+			}
+			if last.Terminal() {
+				t.Fatalf("%s event after the terminal %s event", event.Type, last.Type)
+			}
+			last = event
+		case <-timeout:
+			t.Fatal("stream did not end")
+		}
+	}
+}
+
+func TestStartBeforePreviousSearchDrained(t *testing.T) {
+	g := NewCPUGenerator(2)
+	defer g.Close()
+
+	first, err := g.Start(context.Background(), &hardConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Nobody reads the first stream while the second search starts
+	time.Sleep(50 * time.Millisecond)
+	second, err := g.Start(context.Background(), &hardConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	end := drain(t, first)
+	if end.Type != generator.EventDone {
+		t.Fatalf("first search ended with %s, want done", end.Type)
+	}
+	// The final stats are the first search's, not those of the search that replaced it
+	if end.Stats.Attempts == 0 || end.Stats.ElapsedSecs < 0.05 {
+		t.Errorf("first search ended with stats %+v, want its own", end.Stats)
+	}
+
+	g.Close()
+	if end := drain(t, second); end.Type != generator.EventDone {
+		t.Fatalf("second search ended with %s, want done", end.Type)
+	}
+}
+
+func TestStatsDuringStartAndEnd(t *testing.T) {
+	g := NewCPUGenerator(2)
+	defer g.Close()
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if stats := g.Stats(); stats.ElapsedSecs < 0 || stats.HashRate < 0 {
+				t.Errorf("invalid stats %+v", stats)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := g.Start(ctx, &hardConfig)
+		if err != nil {
+			cancel()
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		if end := drain(t, events); end.Type != generator.EventDone {
+			t.Errorf("search %d ended with %s, want done", i, end.Type)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestCloseTwice(t *testing.T) {
+	g := NewCPUGenerator(2)
+	events, err := g.Start(context.Background(), &hardConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	if end := drain(t, events); end.Type != generator.EventDone {
+		t.Fatalf("search ended with %s, want done", end.Type)
+	}
+
+	if _, err := g.Start(context.Background(), &hardConfig); !errors.Is(err, generator.ErrClosed) {
+		t.Fatalf("Start after Close returned %v, want ErrClosed", err)
+	}
+}
+
+func TestWaitAfterMatch(t *testing.T) {
+	g := NewCPUGenerator(2)
+	defer g.Close()
+
+	events, err := g.Start(context.Background(), &easyConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var match *generator.Result
+	for event := range events {
+		if event.Type == generator.EventMatch {
+			match = event.Result
+			break
+		}
+		if event.Terminal() {
+			t.Fatalf("search ended with %s before a match", event.Type)
+		}
+	}
+	if match == nil {
+		t.Fatal("stream closed without a match")
+	}
+	if !strings.HasPrefix(match.Address, "npub1q") {
+		t.Errorf("match %s does not start with npub1q", match.Address)
+	}
+
+	waited := make(chan struct{})
+	go func() {
+		g.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Wait did not return after the match")
+	}
+
+	if end := drain(t, events); end.Type != generator.EventDone {
+		t.Fatalf("search ended with %s, want done", end.Type)
+	}
+}
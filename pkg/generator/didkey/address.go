@@ -0,0 +1,45 @@
+// Package didkey provides did:key (Ed25519) vanity generation support.
+// DID = "did:key:" + multibase Base58btc ('z') of the multicodec-prefixed public key
+// (0xed 0x01 || pubkey), which always starts with "did:key:z6Mk".
+package didkey
+
+import (
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// MethodPrefix is the DID scheme and method.
+	MethodPrefix = "did:key:"
+	// AddressPrefix is the fixed leading part of every Ed25519 did:key.
+	AddressPrefix = MethodPrefix + "z6Mk"
+
+	multibaseBase58 = "z"
+)
+
+// Multicodec varint prefixes for Ed25519 keys.
+var (
+	ed25519PubCodec  = []byte{0xed, 0x01}
+	ed25519PrivCodec = []byte{0x80, 0x26}
+)
+
+// DeriveDID returns the did:key for an Ed25519 public key.
+func DeriveDID(pubKey []byte) string {
+	return MethodPrefix + PublicKeyMultibase(pubKey)
+}
+
+// PublicKeyMultibase returns the multibase public key ("z6Mk..."), used as the
+// publicKeyMultibase of the DID's verification method.
+func PublicKeyMultibase(pubKey []byte) string {
+	return multibaseBase58 + base58.Encode(append(append([]byte{}, ed25519PubCodec...), pubKey...))
+}
+
+// PrivateKeyMultibase returns the multibase private key ("z3u2..."), the multicodec
+// ed25519-priv encoding of the 32-byte seed.
+func PrivateKeyMultibase(seed []byte) string {
+	return multibaseBase58 + base58.Encode(append(append([]byte{}, ed25519PrivCodec...), seed...))
+}
+
+// VerificationMethod returns the DID URL of the key's verification method ("did:key:z6Mk...#z6Mk...").
+func VerificationMethod(pubKey []byte) string {
+	return DeriveDID(pubKey) + "#" + PublicKeyMultibase(pubKey)
+}
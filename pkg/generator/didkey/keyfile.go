@@ -0,0 +1,31 @@
+package didkey
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// PrivateKeyFile is the file name of the private JWK written next to the result.
+const PrivateKeyFile = "private.jwk"
+
+// jwk is an Ed25519 JSON Web Key (RFC 8037).
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	D   string `json:"d"`
+}
+
+// PrivateKeyJWK returns the private JWK of the DID's key, with the verification method as its kid,
+// as imported by DID libraries and wallets.
+func PrivateKeyJWK(seed, pubKey []byte) []byte {
+	data, _ := json.MarshalIndent(jwk{
+		Kid: VerificationMethod(pubKey),
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(pubKey),
+		D:   base64.RawURLEncoding.EncodeToString(seed),
+	}, "", "  ")
+	return append(data, '\n')
+}
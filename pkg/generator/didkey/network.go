@@ -0,0 +1,66 @@
+package didkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.DIDKey,
+		Name:        "did:key",
+		Title:       "did:key (Ed25519)",
+		Icon:        "🪪",
+		Description: "Base58, did:key:z6Mk prefix",
+		ResultLabel: "DID:KEY IDENTIFIER",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The fixed multicodec bytes limit the range of the following characters
+			return generator.PatternRules{
+				Alphabet:    generator.Base58,
+				Fixed:       AddressPrefix,
+				PrefixChars: []string{NextCharRange()},
+				CheckPrefix: func(prefix string) error {
+					if !IsValidPrefix(prefix) {
+						return &generator.PatternError{Msg: "prefix is outside the range of possible did:key identifiers"}
+					}
+					return nil
+				},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for did:key identifiers (Ed25519 + multicodec + multibase Base58btc)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		did := DeriveDID(pubKey)
+
+		partial, ok := pattern.Check(did[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.DIDKey,
+			Partial:    partial,
+			Address:    did,
+			PrivateKey: PrivateKeyMultibase(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex seed)", Value: hex.EncodeToString(privKey.Seed())},
+				{Label: "Verification Method", Value: VerificationMethod(pubKey)},
+			},
+			Files: []generator.KeyFile{
+				{Name: PrivateKeyFile, Data: PrivateKeyJWK(privKey.Seed(), pubKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,24 @@
+package didkey
+
+import (
+	"bytes"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// Smallest and largest DIDs, from the all-zero and all-0xff public keys.
+// Every Ed25519 did:key has the same length, so they bound the possible prefixes.
+var (
+	minDID = DeriveDID(bytes.Repeat([]byte{0x00}, 32))
+	maxDID = DeriveDID(bytes.Repeat([]byte{0xff}, 32))
+)
+
+// IsValidPrefix checks that a prefix (matched after AddressPrefix) can occur.
+func IsValidPrefix(prefix string) bool {
+	return generator.IsValidPrefixRange(AddressPrefix, minDID, maxDID, prefix)
+}
+
+// NextCharRange returns the characters possible right after AddressPrefix.
+func NextCharRange() string {
+	return generator.PrefixRangeChars(AddressPrefix, minDID, maxDID)
+}
@@ -0,0 +1,20 @@
+// Package ed25519hex is the GPU engine shared by the networks whose address is 32 bytes
+// derived from an Ed25519 public key and shown as 64 hex characters (Aptos, Sui, NEAR).
+// The networks differ only in the derivation, which they provide as a Derivation.
+package ed25519hex
+
+import "github.com/Amr-9/HexHunter/pkg/generator"
+
+// Derivation describes how a network turns an Ed25519 key into its address.
+type Derivation struct {
+	Name string // Engine name, e.g. "GPU (Aptos SHA3-256)"
+
+	// Kernel is OpenCL code defining
+	//   void derive_address(const uchar *public_key, uchar *address)
+	// which writes the 32 bytes the address shows in hex.
+	Kernel string
+
+	// Result returns the result for a seed found by the GPU, and the 64 hex characters
+	// of its address that the pattern is matched against (e.g. without "0x").
+	Result func(seed []byte) (result generator.Result, hex string)
+}
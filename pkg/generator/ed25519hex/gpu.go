@@ -0,0 +1,417 @@
+//go:build opencl
+// +build opencl
+
+package ed25519hex
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../../deps/opencl-headers
+#cgo windows LDFLAGS: -L${SRCDIR}/../../../deps/lib -lOpenCL
+#cgo linux LDFLAGS: -lOpenCL
+#cgo darwin LDFLAGS: -framework OpenCL
+
+#ifdef __APPLE__
+#include <OpenCL/opencl.h>
+#else
+#include <CL/cl.h>
+#endif
+
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"unsafe"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+const (
+	// Batch size for the Ed25519 hex GPU kernel
+	batchSize     = 1 << 20 // 1,048,576 keys per batch
+	localWorkSize = 256
+)
+
+// GPUGenerator implements the Generator interface for an Ed25519 hex network using OpenCL.
+// Uses Ed25519 for keypair generation and the network's Derivation for the address.
+type GPUGenerator struct {
+	derivation Derivation
+
+	platform C.cl_platform_id
+	device   C.cl_device_id
+	clCtx    C.cl_context
+	queue    C.cl_command_queue
+	program  C.cl_program
+	kernel   C.cl_kernel
+
+	// buffers
+	bufSeed          C.cl_mem
+	bufOutput        C.cl_mem
+	bufOccupiedBytes C.cl_mem
+	bufGroupOffset   C.cl_mem
+	bufPrefix        C.cl_mem
+	bufSuffix        C.cl_mem
+	bufContains      C.cl_mem
+
+	// stats
+	lifecycle generator.Lifecycle
+
+	// matching config
+	pattern *generator.Pattern
+}
+
+// NewGPUGenerator creates a new GPU-based generator for the network of a derivation.
+func NewGPUGenerator(derivation Derivation) (*GPUGenerator, error) {
+	g := &GPUGenerator{derivation: derivation}
+	return g, nil
+}
+
+func (g *GPUGenerator) Name() string {
+	return g.derivation.Name
+}
+
+func (g *GPUGenerator) Stats() generator.Stats {
+	return g.lifecycle.Stats()
+}
+
+// UpdatePattern replaces the pattern of the running search. The GPU loop rewrites
+// the pattern buffers before its next batch.
+func (g *GPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return g.lifecycle.UpdatePattern(prefix, suffix, contains)
+}
+
+// Wait blocks until the GPU loop of the last search has exited.
+func (g *GPUGenerator) Wait() {
+	g.lifecycle.Wait()
+}
+
+// Close stops the running search, waits for it and releases the OpenCL context, queue and kernel.
+func (g *GPUGenerator) Close() error {
+	if g.lifecycle.Close() {
+		g.release()
+	}
+	return nil
+}
+
+func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
+	pattern, err := generator.CompilePattern(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Begin waits for the previous search, which still uses the buffers
+	ctx, err = g.lifecycle.Begin(ctx, config, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// OpenCL is set up by the first search and kept until Close
+	if g.kernel == nil {
+		if err := g.initOpenCL(); err != nil {
+			g.release()
+			g.lifecycle.End()
+			return nil, fmt.Errorf("failed to initialize OpenCL: %w", err)
+		}
+	}
+
+	stream := generator.NewStream(ctx, config, g.Stats)
+	go func() {
+		err := g.runGPU(ctx, stream)
+		stream.Finish(g.lifecycle.End(), err)
+	}()
+	return stream.Events(), nil
+}
+
+func (g *GPUGenerator) runGPU(ctx context.Context, stream *generator.Stream) error {
+	if err := g.createBuffers(); err != nil {
+		return fmt.Errorf("buffer creation failed: %w", err)
+	}
+	defer g.releaseBuffers()
+
+	baseSeed := make([]byte, 32)
+	hostOutput := make([]byte, 33)
+	zeros := make([]byte, 33)
+
+	var occupiedBytes byte = 3
+	var groupOffset byte = 0
+	var ret C.cl_int
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			// Apply a pattern replaced by UpdatePattern before the next batch
+			if pattern := g.lifecycle.Pattern(); pattern != g.pattern {
+				if err := g.writePattern(pattern); err != nil {
+					return fmt.Errorf("pattern update failed: %w", err)
+				}
+			}
+
+			rand.Read(baseSeed)
+
+			ret = C.clEnqueueWriteBuffer(g.queue, g.bufSeed, C.CL_TRUE, 0, 32,
+				unsafe.Pointer(&baseSeed[0]), 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("failed to write seed: %d", ret)
+			}
+
+			ret = C.clEnqueueWriteBuffer(g.queue, g.bufOccupiedBytes, C.CL_TRUE, 0, 1,
+				unsafe.Pointer(&occupiedBytes), 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("failed to write occupied_bytes: %d", ret)
+			}
+
+			ret = C.clEnqueueWriteBuffer(g.queue, g.bufGroupOffset, C.CL_TRUE, 0, 1,
+				unsafe.Pointer(&groupOffset), 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("failed to write group_offset: %d", ret)
+			}
+
+			ret = C.clEnqueueWriteBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, 33,
+				unsafe.Pointer(&zeros[0]), 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("failed to clear output: %d", ret)
+			}
+
+			globalSize := C.size_t(batchSize)
+			localSize := C.size_t(localWorkSize)
+			ret = C.clEnqueueNDRangeKernel(g.queue, g.kernel, 1, nil,
+				&globalSize, &localSize, 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("kernel failed: %d", ret)
+			}
+
+			ret = C.clEnqueueReadBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, 33,
+				unsafe.Pointer(&hostOutput[0]), 0, nil, nil)
+			if ret != C.CL_SUCCESS {
+				return fmt.Errorf("read output failed: %d", ret)
+			}
+
+			// Count the batch before a match, so the final stats include it
+			g.lifecycle.Add(uint64(batchSize))
+
+			// Check if kernel found a match
+			if hostOutput[0] != 0 {
+				foundSeed := hostOutput[1:33]
+
+				// Verify with Go's implementation
+				result, hex := g.derivation.Result(foundSeed)
+
+				if g.pattern.Match(hex) {
+					stream.Match(result)
+					return nil
+				}
+				stream.Warn(fmt.Errorf("GPU false positive: address=%s", result.Address))
+			}
+
+			groupOffset++
+			if groupOffset == 0 {
+				groupOffset = 0
+			}
+		}
+	}
+}
+
+func (g *GPUGenerator) initOpenCL() error {
+	var ret C.cl_int
+	var numPlatforms C.cl_uint
+	if C.clGetPlatformIDs(0, nil, &numPlatforms) != C.CL_SUCCESS || numPlatforms == 0 {
+		return fmt.Errorf("no OpenCL platforms")
+	}
+	platforms := make([]C.cl_platform_id, numPlatforms)
+	C.clGetPlatformIDs(numPlatforms, &platforms[0], nil)
+	g.platform = platforms[0]
+
+	var numDevices C.cl_uint
+	if C.clGetDeviceIDs(g.platform, C.CL_DEVICE_TYPE_GPU, 0, nil, &numDevices) != C.CL_SUCCESS || numDevices == 0 {
+		return fmt.Errorf("no GPU devices")
+	}
+	devices := make([]C.cl_device_id, numDevices)
+	C.clGetDeviceIDs(g.platform, C.CL_DEVICE_TYPE_GPU, numDevices, &devices[0], nil)
+	g.device = devices[0]
+
+	g.clCtx = C.clCreateContext(nil, 1, &g.device, nil, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("context failed: %d", ret)
+	}
+
+	g.queue = C.clCreateCommandQueue(g.clCtx, g.device, 0, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("queue failed: %d", ret)
+	}
+
+	// Load the hex kernel with the network's address derivation
+	kernelSrc, err := loadKernel(g.derivation.Kernel)
+	if err != nil {
+		return fmt.Errorf("failed to load kernel source: %w", err)
+	}
+
+	src := C.CString(kernelSrc)
+	defer C.free(unsafe.Pointer(src))
+
+	length := C.size_t(len(kernelSrc))
+	g.program = C.clCreateProgramWithSource(g.clCtx, 1, &src, &length, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("program creation failed: %d", ret)
+	}
+
+	buildOptions := C.CString("-cl-fast-relaxed-math -cl-mad-enable")
+	defer C.free(unsafe.Pointer(buildOptions))
+
+	ret = C.clBuildProgram(g.program, 1, &g.device, buildOptions, nil, nil)
+	if ret != C.CL_SUCCESS {
+		var logSize C.size_t
+		C.clGetProgramBuildInfo(g.program, g.device, C.CL_PROGRAM_BUILD_LOG, 0, nil, &logSize)
+		buildLog := make([]byte, logSize)
+		C.clGetProgramBuildInfo(g.program, g.device, C.CL_PROGRAM_BUILD_LOG, logSize, unsafe.Pointer(&buildLog[0]), nil)
+		return fmt.Errorf("build failed: %s", string(buildLog))
+	}
+
+	kName := C.CString(kernelName)
+	defer C.free(unsafe.Pointer(kName))
+	g.kernel = C.clCreateKernel(g.program, kName, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("kernel creation failed: %d", ret)
+	}
+
+	return nil
+}
+
+func (g *GPUGenerator) createBuffers() error {
+	var ret C.cl_int
+
+	g.bufSeed = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 32, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufSeed failed: %d", ret)
+	}
+
+	g.bufOutput = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_WRITE, 33, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufOutput failed: %d", ret)
+	}
+
+	g.bufOccupiedBytes = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 1, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufOccupiedBytes failed: %d", ret)
+	}
+
+	g.bufGroupOffset = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 1, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufGroupOffset failed: %d", ret)
+	}
+
+	g.bufPrefix = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 64, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufPrefix failed: %d", ret)
+	}
+
+	g.bufSuffix = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 64, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufSuffix failed: %d", ret)
+	}
+
+	// 7. Contains buffer (64 bytes max for hex address)
+	g.bufContains = C.clCreateBuffer(g.clCtx, C.CL_MEM_READ_ONLY, 64, nil, &ret)
+	if ret != C.CL_SUCCESS {
+		return fmt.Errorf("bufContains failed: %d", ret)
+	}
+
+	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufSeed)), unsafe.Pointer(&g.bufSeed))
+	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
+	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOccupiedBytes)), unsafe.Pointer(&g.bufOccupiedBytes))
+	C.clSetKernelArg(g.kernel, 3, C.size_t(unsafe.Sizeof(g.bufGroupOffset)), unsafe.Pointer(&g.bufGroupOffset))
+	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufPrefix)), unsafe.Pointer(&g.bufPrefix))
+	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufSuffix)), unsafe.Pointer(&g.bufSuffix))
+	C.clSetKernelArg(g.kernel, 6, C.size_t(unsafe.Sizeof(g.bufContains)), unsafe.Pointer(&g.bufContains))
+
+	caseSensitive := C.uint(0) // Hex is case-insensitive
+	C.clSetKernelArg(g.kernel, 10, C.size_t(unsafe.Sizeof(caseSensitive)), unsafe.Pointer(&caseSensitive))
+
+	return g.writePattern(g.lifecycle.Pattern())
+}
+
+// writePattern writes prefix/suffix/contains and their lengths to the GPU.
+// It runs when the buffers are created and between batches after UpdatePattern.
+func (g *GPUGenerator) writePattern(pattern *generator.Pattern) error {
+	gpu := pattern.GPU()
+
+	targets := []struct {
+		name string
+		buf  C.cl_mem
+		data []byte
+	}{
+		{"prefix", g.bufPrefix, gpu.Prefix},
+		{"suffix", g.bufSuffix, gpu.Suffix},
+		{"contains", g.bufContains, gpu.Contains},
+	}
+	for _, target := range targets {
+		if len(target.data) == 0 {
+			continue
+		}
+		if len(target.data) > 64 {
+			return fmt.Errorf("%s is longer than an address", target.name)
+		}
+		ret := C.clEnqueueWriteBuffer(g.queue, target.buf, C.CL_TRUE, 0,
+			C.size_t(len(target.data)), unsafe.Pointer(&target.data[0]), 0, nil, nil)
+		if ret != C.CL_SUCCESS {
+			return fmt.Errorf("failed to write %s: %d", target.name, ret)
+		}
+	}
+
+	prefixLen := C.uint(len(gpu.Prefix))
+	suffixLen := C.uint(len(gpu.Suffix))
+	containsLen := C.uint(len(gpu.Contains))
+	C.clSetKernelArg(g.kernel, 7, C.size_t(unsafe.Sizeof(prefixLen)), unsafe.Pointer(&prefixLen))
+	C.clSetKernelArg(g.kernel, 8, C.size_t(unsafe.Sizeof(suffixLen)), unsafe.Pointer(&suffixLen))
+	C.clSetKernelArg(g.kernel, 9, C.size_t(unsafe.Sizeof(containsLen)), unsafe.Pointer(&containsLen))
+
+	g.pattern = pattern
+	return nil
+}
+
+func (g *GPUGenerator) releaseBuffers() {
+	if g.bufSeed != nil {
+		C.clReleaseMemObject(g.bufSeed)
+	}
+	if g.bufOutput != nil {
+		C.clReleaseMemObject(g.bufOutput)
+	}
+	if g.bufOccupiedBytes != nil {
+		C.clReleaseMemObject(g.bufOccupiedBytes)
+	}
+	if g.bufGroupOffset != nil {
+		C.clReleaseMemObject(g.bufGroupOffset)
+	}
+	if g.bufPrefix != nil {
+		C.clReleaseMemObject(g.bufPrefix)
+	}
+	if g.bufSuffix != nil {
+		C.clReleaseMemObject(g.bufSuffix)
+	}
+	if g.bufContains != nil {
+		C.clReleaseMemObject(g.bufContains)
+	}
+}
+
+func (g *GPUGenerator) release() {
+	if g.kernel != nil {
+		C.clReleaseKernel(g.kernel)
+		g.kernel = nil
+	}
+	if g.program != nil {
+		C.clReleaseProgram(g.program)
+		g.program = nil
+	}
+	if g.queue != nil {
+		C.clReleaseCommandQueue(g.queue)
+		g.queue = nil
+	}
+	if g.clCtx != nil {
+		C.clReleaseContext(g.clCtx)
+		g.clCtx = nil
+	}
+}
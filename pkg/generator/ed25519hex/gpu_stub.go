@@ -0,0 +1,47 @@
+//go:build !opencl
+// +build !opencl
+
+package ed25519hex
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// GPUGenerator is a stub for non-OpenCL builds.
+type GPUGenerator struct{}
+
+// NewGPUGenerator returns an error for non-OpenCL builds.
+func NewGPUGenerator(derivation Derivation) (*GPUGenerator, error) {
+	return nil, errors.New("GPU support requires OpenCL build tags")
+}
+
+// Name returns the implementation name.
+func (g *GPUGenerator) Name() string {
+	return "GPU (Ed25519 hex - Not Available)"
+}
+
+// Stats returns empty stats for stub.
+func (g *GPUGenerator) Stats() generator.Stats {
+	return generator.Stats{}
+}
+
+// Start is a stub that returns an error.
+func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
+	return nil, errors.New("GPU support requires OpenCL build tags")
+}
+
+// UpdatePattern is a stub that returns an error.
+func (g *GPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return errors.New("GPU support requires OpenCL build tags")
+}
+
+// Wait does nothing.
+func (g *GPUGenerator) Wait() {}
+
+// Close does nothing.
+func (g *GPUGenerator) Close() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+//go:build opencl
+// +build opencl
+
+package ed25519hex
+
+import (
+	"embed"
+
+	"github.com/Amr-9/HexHunter/pkg/generator/common"
+)
+
+//go:embed kernels/ed25519_hex_kernel.cl
+var hexKernelFS embed.FS
+
+// kernelName is the name of the main kernel function
+const kernelName = "generate_ed25519_hex_address"
+
+// loadKernel combines the Ed25519 core, the network's derive_address and the shared hex kernel.
+func loadKernel(derive string) (string, error) {
+	// 1. Load shared Ed25519 core
+	coreKernel, err := common.LoadEd25519Core()
+	if err != nil {
+		return "", err
+	}
+
+	// 2. Load the hex kernel (hex encoding, pattern matching, kernel function)
+	hexData, err := hexKernelFS.ReadFile("kernels/ed25519_hex_kernel.cl")
+	if err != nil {
+		return "", err
+	}
+
+	// 3. Concatenate: core + derivation + kernel
+	combined := coreKernel + "\n" + derive + "\n" + string(hexData)
+
+	// 4. Apply common OpenCL fixes for AMD/Intel compatibility
+	return common.ApplyOpenCLFixes(combined), nil
+}
@@ -0,0 +1,22 @@
+package ethereum
+
+import (
+	"encoding/hex"
+)
+
+// hexEncode encodes src into dst as lowercase hexadecimal.
+// dst must be at least len(src)*2 bytes.
+// This is a simplified version that avoids the overhead of hex.Encode.
+func hexEncode(dst, src []byte) {
+	const hextable = "0123456789abcdef"
+	for i, v := range src {
+		dst[i*2] = hextable[v>>4]
+		dst[i*2+1] = hextable[v&0x0f]
+	}
+}
+
+// AddressToHex converts raw address bytes to a 0x-prefixed hex string.
+// Used for the final result output only (not in the hot loop).
+func AddressToHex(addressBytes []byte) string {
+	return "0x" + hex.EncodeToString(addressBytes)
+}
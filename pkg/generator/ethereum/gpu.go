@@ -27,11 +27,8 @@ import (
 	_ "embed"
 	"encoding/hex"
 	"fmt"
-	"log"
 	"math/big"
 	"os"
-	"sync/atomic"
-	"time"
 	"unsafe"
 
 	"github.com/Amr-9/HexHunter/pkg/generator"
@@ -71,16 +68,10 @@ type GPUGenerator struct {
 	curve *secp256k1.BitCurve
 
 	// stats
-	attempts  uint64
-	startTime time.Time
+	lifecycle generator.Lifecycle
 
 	// matching
-	prefixBytes   []byte
-	suffixBytes   []byte
-	containsBytes []byte
-	prefixIsOdd   bool // true if original prefix hex length was odd
-	suffixIsOdd   bool // true if original suffix hex length was odd
-	containsIsOdd bool // true if original contains hex length was odd
+	pattern *generator.Pattern // Pattern in the GPU buffers
 }
 
 // GPUInfo contains information about an available GPU device
@@ -98,6 +89,7 @@ func NewGPUGenerator() (*GPUGenerator, error) {
 		curve: secp256k1.S256(),
 	}
 	if err := g.initOpenCL(); err != nil {
+		g.release()
 		return nil, fmt.Errorf("failed to initialize OpenCL: %w", err)
 	}
 	return g, nil
@@ -108,60 +100,52 @@ func (g *GPUGenerator) Name() string {
 }
 
 func (g *GPUGenerator) Stats() generator.Stats {
-	attempts := atomic.LoadUint64(&g.attempts)
-	elapsed := time.Since(g.startTime).Seconds()
-	var hashRate float64
-	if elapsed > 0 {
-		hashRate = float64(attempts) / elapsed
-	}
-	return generator.Stats{
-		Attempts:    attempts,
-		HashRate:    hashRate,
-		ElapsedSecs: elapsed,
+	return g.lifecycle.Stats()
+}
+
+// UpdatePattern replaces the pattern of the running search. The GPU loop rewrites
+// the pattern buffers before its next batch.
+func (g *GPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return g.lifecycle.UpdatePattern(prefix, suffix, contains)
+}
+
+// Wait blocks until the GPU loop of the last search has exited.
+func (g *GPUGenerator) Wait() {
+	g.lifecycle.Wait()
+}
+
+// Close stops the running search, waits for it and releases the OpenCL context, queue and kernel.
+func (g *GPUGenerator) Close() error {
+	if g.lifecycle.Close() {
+		g.release()
 	}
+	return nil
 }
 
-func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
-	resultChan := make(chan generator.Result, 1)
-	g.startTime = time.Now()
-	atomic.StoreUint64(&g.attempts, 0)
-
-	// IMPORTANT: Reset prefix/suffix from previous search!
-	g.prefixBytes = nil
-	g.suffixBytes = nil
-	g.prefixIsOdd = false
-	g.prefixIsOdd = false
-	g.suffixIsOdd = false
-	g.containsIsOdd = false
-	g.containsBytes = nil
-
-	if config.Prefix != "" {
-		g.prefixIsOdd = len(config.Prefix)%2 == 1 // Track before padding!
-		g.prefixBytes, _ = hex.DecodeString(padHex(config.Prefix))
-	}
-	if config.Suffix != "" {
-		g.suffixIsOdd = len(config.Suffix)%2 == 1 // Track before padding!
-		// For suffix: pad at BEGINNING (not end!) so low nibble is correct
-		paddedSuffix := config.Suffix
-		if g.suffixIsOdd {
-			paddedSuffix = "0" + config.Suffix // Pad at start for low nibble
-		}
-		g.suffixBytes, _ = hex.DecodeString(paddedSuffix)
+func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
+	pattern, err := generator.CompilePattern(config)
+	if err != nil {
+		return nil, err
 	}
-	if config.Contains != "" {
-		g.containsIsOdd = len(config.Contains)%2 == 1
-		g.containsBytes, _ = hex.DecodeString(padHex(config.Contains))
+
+	// Begin waits for the previous search, which still uses the buffers
+	ctx, err = g.lifecycle.Begin(ctx, config, pattern)
+	if err != nil {
+		return nil, err
 	}
 
-	go g.runGPU(ctx, resultChan, config)
-	return resultChan, nil
+	stream := generator.NewStream(ctx, config, g.Stats)
+	go func() {
+		err := g.runGPU(ctx, stream, config)
+		stream.Finish(g.lifecycle.End(), err)
+	}()
+	return stream.Events(), nil
 }
 
-func (g *GPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.Result, config *generator.Config) {
+func (g *GPUGenerator) runGPU(ctx context.Context, stream *generator.Stream, config *generator.Config) error {
 	// Create buffers
 	if err := g.createBuffers(); err != nil {
-		log.Printf("GPU Buffer Error: %v", err)
-		return
+		return fmt.Errorf("GPU buffer error: %w", err)
 	}
 	defer g.releaseBuffers()
 
@@ -182,20 +166,25 @@ func (g *GPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.R
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		default:
+			// Apply a pattern replaced by UpdatePattern before the next batch
+			if pattern := g.lifecycle.Pattern(); pattern != g.pattern {
+				if err := g.writePattern(pattern); err != nil {
+					return fmt.Errorf("pattern update failed: %w", err)
+				}
+			}
+
 			// 1. Reset found_flag and found_gid before each batch
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufFlag, C.CL_TRUE, 0, 4,
 				unsafe.Pointer(&zero), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to reset flag: %d", ret)
-				return
+				return fmt.Errorf("failed to reset flag: %d", ret)
 			}
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufFoundGid, C.CL_TRUE, 0, 4,
 				unsafe.Pointer(&zero), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to reset gid: %d", ret)
-				return
+				return fmt.Errorf("failed to reset gid: %d", ret)
 			}
 
 			// 2. Compute BasePoint = base * G on CPU (in Jacobian form)
@@ -205,8 +194,7 @@ func (g *GPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.R
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufBasePoint, C.CL_TRUE, 0, 96,
 				unsafe.Pointer(&basePointBytes[0]), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to write base point: %d", ret)
-				return
+				return fmt.Errorf("failed to write base point: %d", ret)
 			}
 
 			// 4. Run Kernel
@@ -214,34 +202,33 @@ func (g *GPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.R
 			localSize := C.size_t(localWorkSize)
 			ret = C.clEnqueueNDRangeKernel(g.queue, g.kernel, 1, nil, &globalSize, &localSize, 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Kernel execution failed: %d", ret)
-				return
+				return fmt.Errorf("kernel execution failed: %d", ret)
 			}
 
 			// 5. Read ONLY the flag (4 bytes!) - this is the key optimization
 			ret = C.clEnqueueReadBuffer(g.queue, g.bufFlag, C.CL_TRUE, 0, 4,
 				unsafe.Pointer(&foundFlag), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Read flag failed: %d", ret)
-				return
+				return fmt.Errorf("read flag failed: %d", ret)
 			}
 
-			// 6. If found, read the result and return
+			// 6. Update stats, before a match so the final stats include this batch
+			g.lifecycle.Add(uint64(globalWorkSize))
+
+			// 7. If found, read the result and return
 			if foundFlag != 0 {
 				// Read found GID
 				ret = C.clEnqueueReadBuffer(g.queue, g.bufFoundGid, C.CL_TRUE, 0, 4,
 					unsafe.Pointer(&foundGid), 0, nil, nil)
 				if ret != C.CL_SUCCESS {
-					log.Printf("Read gid failed: %d", ret)
-					return
+					return fmt.Errorf("read gid failed: %d", ret)
 				}
 
 				// Read address from output buffer
 				ret = C.clEnqueueReadBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, 20,
 					unsafe.Pointer(&hostOutput[0]), 0, nil, nil)
 				if ret != C.CL_SUCCESS {
-					log.Printf("Read output failed: %d", ret)
-					return
+					return fmt.Errorf("read output failed: %d", ret)
 				}
 
 				// Reconstruct Private Key: base + found_gid
@@ -252,15 +239,19 @@ func (g *GPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.R
 				pk, _ := crypto.ToECDSA(privBytes)
 				pub := crypto.PubkeyToAddress(pk.PublicKey)
 
-				resultChan <- generator.Result{
-					Address:    pub.Hex(),
-					PrivateKey: hex.EncodeToString(privBytes),
+				var addrHex [40]byte
+				hexEncode(addrHex[:], pub.Bytes())
+				if g.pattern.MatchBytes(addrHex[:]) {
+					stream.Match(generator.Result{
+						Address:    pub.Hex(),
+						PrivateKey: hex.EncodeToString(privBytes),
+					})
+					return nil
 				}
-				return
+				stream.Warn(fmt.Errorf("GPU false positive: address=%s", pub.Hex()))
 			}
 
-			// 7. Advance stats and base key
-			atomic.AddUint64(&g.attempts, uint64(globalWorkSize))
+			// 8. Advance base key
 			baseInt.Add(baseInt, batchSizeInt)
 		}
 	}
@@ -395,68 +386,91 @@ func (g *GPUGenerator) createBuffers() error {
 	}
 
 	// 6. Target Prefix (20 bytes max, using __constant memory)
-	prefixData := make([]byte, 20)
-	copy(prefixData, g.prefixBytes)
-	g.bufTargetPfx = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		20, unsafe.Pointer(&prefixData[0]), &ret)
+	g.bufTargetPfx = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 20, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufTargetPfx failed: %d", ret)
 	}
 
 	// 7. Target Suffix (20 bytes max, using __constant memory)
-	suffixData := make([]byte, 20)
-	copy(suffixData, g.suffixBytes)
-	g.bufTargetSfx = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		20, unsafe.Pointer(&suffixData[0]), &ret)
+	g.bufTargetSfx = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 20, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufTargetSfx failed: %d", ret)
 	}
 
 	// 8. Target Contains (20 bytes max, using __constant memory)
-	containsData := make([]byte, 20)
-	if len(g.containsBytes) > 0 {
-		copy(containsData, g.containsBytes)
-	}
-	g.bufTargetCnt = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		20, unsafe.Pointer(&containsData[0]), &ret)
+	g.bufTargetCnt = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 20, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufTargetCnt failed: %d", ret)
 	}
 
-	// Set Kernel Args (14 total)
-	prefixLen := C.uint(len(g.prefixBytes))
-	suffixLen := C.uint(len(g.suffixBytes))
-	containsLen := C.uint(len(g.containsBytes))
+	// Set Kernel Args (14 total; the pattern lengths and odd flags are set with the pattern)
+	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufBasePoint)), unsafe.Pointer(&g.bufBasePoint))
+	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufTable)), unsafe.Pointer(&g.bufTable))
+	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
+	C.clSetKernelArg(g.kernel, 3, C.size_t(unsafe.Sizeof(g.bufFlag)), unsafe.Pointer(&g.bufFlag))
+	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufFoundGid)), unsafe.Pointer(&g.bufFoundGid))
+	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufTargetPfx)), unsafe.Pointer(&g.bufTargetPfx))
+	C.clSetKernelArg(g.kernel, 7, C.size_t(unsafe.Sizeof(g.bufTargetSfx)), unsafe.Pointer(&g.bufTargetSfx))
+	C.clSetKernelArg(g.kernel, 11, C.size_t(unsafe.Sizeof(g.bufTargetCnt)), unsafe.Pointer(&g.bufTargetCnt))
+
+	return g.writePattern(g.lifecycle.Pattern())
+}
+
+// writePattern uploads the target patterns, lengths and odd flags to the GPU.
+// It runs when the buffers are created and between batches after UpdatePattern.
+func (g *GPUGenerator) writePattern(pattern *generator.Pattern) error {
+	// The kernel compares raw hash bytes, so patterns are packed two hex characters per byte
+	gpu := pattern.GPU()
+
+	targets := []struct {
+		name string
+		buf  C.cl_mem
+		data []byte
+	}{
+		{"prefix", g.bufTargetPfx, gpu.PrefixHex},
+		{"suffix", g.bufTargetSfx, gpu.SuffixHex},
+		{"contains", g.bufTargetCnt, gpu.ContainsHex},
+	}
+	for _, target := range targets {
+		if len(target.data) > 20 {
+			return fmt.Errorf("%s is longer than an address", target.name)
+		}
+		// Zero-padded to the buffer size, so a shorter pattern leaves no stale bytes
+		data := make([]byte, 20)
+		copy(data, target.data)
+		ret := C.clEnqueueWriteBuffer(g.queue, target.buf, C.CL_TRUE, 0, 20,
+			unsafe.Pointer(&data[0]), 0, nil, nil)
+		if ret != C.CL_SUCCESS {
+			return fmt.Errorf("failed to write %s: %d", target.name, ret)
+		}
+	}
+
+	prefixLen := C.uint(len(gpu.PrefixHex))
+	suffixLen := C.uint(len(gpu.SuffixHex))
+	containsLen := C.uint(len(gpu.ContainsHex))
 
 	// Convert bool to uint for kernel
 	prefixOdd := C.uint(0)
-	if g.prefixIsOdd {
+	if gpu.PrefixOdd {
 		prefixOdd = 1
 	}
 	suffixOdd := C.uint(0)
-	if g.suffixIsOdd {
+	if gpu.SuffixOdd {
 		suffixOdd = 1
 	}
 	containsOdd := C.uint(0)
-	if g.containsIsOdd {
+	if gpu.ContainsOdd {
 		containsOdd = 1
 	}
 
-	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufBasePoint)), unsafe.Pointer(&g.bufBasePoint))
-	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufTable)), unsafe.Pointer(&g.bufTable))
-	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
-	C.clSetKernelArg(g.kernel, 3, C.size_t(unsafe.Sizeof(g.bufFlag)), unsafe.Pointer(&g.bufFlag))
-	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufFoundGid)), unsafe.Pointer(&g.bufFoundGid))
-	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufTargetPfx)), unsafe.Pointer(&g.bufTargetPfx))
 	C.clSetKernelArg(g.kernel, 6, C.size_t(unsafe.Sizeof(prefixLen)), unsafe.Pointer(&prefixLen))
-	C.clSetKernelArg(g.kernel, 7, C.size_t(unsafe.Sizeof(g.bufTargetSfx)), unsafe.Pointer(&g.bufTargetSfx))
 	C.clSetKernelArg(g.kernel, 8, C.size_t(unsafe.Sizeof(suffixLen)), unsafe.Pointer(&suffixLen))
 	C.clSetKernelArg(g.kernel, 9, C.size_t(unsafe.Sizeof(prefixOdd)), unsafe.Pointer(&prefixOdd))
 	C.clSetKernelArg(g.kernel, 10, C.size_t(unsafe.Sizeof(suffixOdd)), unsafe.Pointer(&suffixOdd))
-	C.clSetKernelArg(g.kernel, 11, C.size_t(unsafe.Sizeof(g.bufTargetCnt)), unsafe.Pointer(&g.bufTargetCnt))
 	C.clSetKernelArg(g.kernel, 12, C.size_t(unsafe.Sizeof(containsLen)), unsafe.Pointer(&containsLen))
 	C.clSetKernelArg(g.kernel, 13, C.size_t(unsafe.Sizeof(containsOdd)), unsafe.Pointer(&containsOdd))
 
+	g.pattern = pattern
 	return nil
 }
 
@@ -502,29 +516,26 @@ func (g *GPUGenerator) releaseBuffers() {
 	}
 }
 
-func (g *GPUGenerator) Release() {
+func (g *GPUGenerator) release() {
 	if g.kernel != nil {
 		C.clReleaseKernel(g.kernel)
+		g.kernel = nil
 	}
 	if g.program != nil {
 		C.clReleaseProgram(g.program)
+		g.program = nil
 	}
 	if g.queue != nil {
 		C.clReleaseCommandQueue(g.queue)
+		g.queue = nil
 	}
 	if g.context != nil {
 		C.clReleaseContext(g.context)
+		g.context = nil
 	}
 }
 
 // Helpers
-func padHex(s string) string {
-	if len(s)%2 == 1 {
-		return s + "0"
-	}
-	return s
-}
-
 func pad32(b []byte) []byte {
 	if len(b) >= 32 {
 		return b
@@ -39,12 +39,22 @@ func (g *GPUGenerator) Stats() generator.Stats {
 }
 
 // Start returns an error as GPU is not available.
-func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
+func (g *GPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
 	return nil, fmt.Errorf("GPU support not compiled")
 }
 
-// Release does nothing.
-func (g *GPUGenerator) Release() {}
+// UpdatePattern returns an error as GPU is not available.
+func (g *GPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return fmt.Errorf("GPU support not compiled")
+}
+
+// Wait does nothing.
+func (g *GPUGenerator) Wait() {}
+
+// Close does nothing.
+func (g *GPUGenerator) Close() error {
+	return nil
+}
 
 // GetGPUInfo returns an error when OpenCL is not enabled.
 func GetGPUInfo() ([]GPUInfo, error) {
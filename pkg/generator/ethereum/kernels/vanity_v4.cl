@@ -10,6 +10,9 @@ typedef struct { uint256 x; uint256 y; } point_a;
 
 #define WORKGROUP_SIZE 256
 
+/* Hex character n of a byte buffer (high nibble first) */
+#define NIBBLE(buf, n) (((n) & 1) ? ((buf)[(n) >> 1] & 0x0F) : ((buf)[(n) >> 1] >> 4))
+
 /* Constants */
 __constant uint256 P_CONST = {{ 0xFFFFFC2F, 0xFFFFFFFE, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF }};
 __constant uint256 ONE = {{ 1, 0, 0, 0, 0, 0, 0, 0 }};
@@ -404,38 +407,23 @@ __kernel void compute_address(
     }
     
     // Check contains - search for pattern anywhere in the middle section
-    // Middle section is between prefix and suffix
+    // Middle section is between prefix and suffix, counted in hex characters (nibbles)
+    // so odd-length patterns match at every position, like the CPU matcher
     if (match && contains_len > 0) {
-        // Calculate search range
-        uint start_pos = prefix_len;  // Start after prefix
-        uint end_pos = 20 - suffix_len;  // End before suffix
-        
-        if (end_pos <= start_pos || contains_len > end_pos - start_pos) {
+        uint start_pos = prefix_len * 2 - prefix_is_odd;         // First nibble after prefix
+        uint end_pos = 40 - (suffix_len * 2 - suffix_is_odd);    // First nibble of suffix
+        uint count = contains_len * 2 - contains_is_odd;         // Contains length in nibbles
+
+        if (end_pos < start_pos || count > end_pos - start_pos) {
             match = false;
         } else {
             bool contains_found = false;
-            // Slide window through middle section
-            for (uint pos = start_pos; pos <= end_pos - contains_len && !contains_found; pos++) {
+            // Slide window through middle section one nibble at a time
+            for (uint pos = start_pos; pos + count <= end_pos && !contains_found; pos++) {
                 bool pos_match = true;
-                for (uint i = 0; i < contains_len && pos_match; i++) {
-                    uchar addr_byte = h[12 + pos + i];
-                    uchar target_byte = target_contains[i];
-                    
-                    // Handle odd nibble at start/end
-                    if (i == 0 && contains_is_odd && contains_len == 1) {
-                        // Single byte odd: compare low nibble
-                        if ((addr_byte & 0x0F) != (target_byte & 0x0F)) {
-                            pos_match = false;
-                        }
-                    } else if (i == contains_len - 1 && contains_is_odd) {
-                        // Last byte of odd contains: compare high nibble
-                        if ((addr_byte & 0xF0) != (target_byte & 0xF0)) {
-                            pos_match = false;
-                        }
-                    } else {
-                        if (addr_byte != target_byte) {
-                            pos_match = false;
-                        }
+                for (uint i = 0; i < count && pos_match; i++) {
+                    if (NIBBLE(h + 12, pos + i) != NIBBLE(target_contains, i)) {
+                        pos_match = false;
                     }
                 }
                 if (pos_match) {
@@ -0,0 +1,57 @@
+package ethereum
+
+import (
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Ethereum,
+		Name:        "Ethereum",
+		Title:       "Ethereum (ETH)",
+		Icon:        "⟠",
+		Description: "0x prefix, Hex",
+		ResultLabel: "ETHEREUM ADDRESS",
+		KeyType:     generator.KeySecp256k1,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.HexRules("0x")
+		},
+		NewWorker: newWorker,
+		GPU: func() (generator.Generator, error) {
+			gen, err := NewGPUGenerator()
+			if err != nil {
+				return nil, err
+			}
+			return gen, nil
+		},
+	})
+}
+
+// newWorker returns a CPU search step for Ethereum addresses (secp256k1 + Keccak-256)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		privateKey, err := crypto.GenerateKey()
+		if err != nil {
+			return 0, nil
+		}
+
+		address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+		// Match the lowercase hex without allocating
+		var buf [40]byte
+		hexEncode(buf[:], address.Bytes())
+		partial, ok := pattern.CheckBytes(buf[:])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.Ethereum,
+			Partial:    partial,
+			Address:    address.Hex(),
+			PrivateKey: hex.EncodeToString(crypto.FromECDSA(privateKey)),
+		}
+	}, nil
+}
@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultProgressInterval is how often progress events are sent when Config.ProgressInterval is 0.
+const DefaultProgressInterval = 100 * time.Millisecond
+
+// EventType is the kind of a search event.
+type EventType int
+
+const (
+	EventProgress EventType = iota // Periodic statistics
+	EventBest                      // Closest candidate so far (CPU search only); Result.Partial is set
+	EventMatch                     // A matching key was found
+	EventError                     // The search failed; terminal
+	EventDone                      // The search ended after a match or cancellation; terminal
+	EventNotFound                  // The search reached a limit without a match; terminal. Err says which (see ErrNotFound)
+	EventWarning                   // A problem the search recovered from (e.g. a GPU false positive); Err says what
+)
+
+// ErrNotFound is wrapped by the error of EventNotFound.
+var ErrNotFound = errors.New("no match found")
+
+// String returns the event type name.
+func (t EventType) String() string {
+	switch t {
+	case EventProgress:
+		return "progress"
+	case EventBest:
+		return "best"
+	case EventMatch:
+		return "match"
+	case EventError:
+		return "error"
+	case EventDone:
+		return "done"
+	case EventNotFound:
+		return "not found"
+	case EventWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is one message of a search's event stream.
+type Event struct {
+	Type    EventType
+	Stats   Stats    // Statistics when the event was sent
+	Result  *Result  // Found key, for EventBest and EventMatch
+	Pattern *Pattern // Pattern Result.Partial counts against, for EventBest (see Generator.UpdatePattern)
+	Err     error    // Why the search failed, for EventError; which limit was reached, for EventNotFound; the problem, for EventWarning
+}
+
+// Terminal reports whether the event ends the stream.
+func (e Event) Terminal() bool {
+	return e.Type == EventError || e.Type == EventDone || e.Type == EventNotFound
+}
+
+// Stream is the sending side of a search's event stream, shared by the backends.
+// It sends progress events on its own, and makes sure the stream ends with exactly
+// one terminal event before it is closed.
+type Stream struct {
+	ctx     context.Context
+	events  chan Event
+	stats   func() Stats
+	stop    chan struct{}
+	ticker  sync.WaitGroup
+	once    sync.Once
+	matched atomic.Bool // A match was delivered
+}
+
+// NewStream starts a stream that reports stats every config.ProgressInterval.
+func NewStream(ctx context.Context, config *Config, stats func() Stats) *Stream {
+	interval := config.ProgressInterval
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	s := &Stream{
+		ctx:    ctx,
+		events: make(chan Event, 16),
+		stats:  stats,
+		stop:   make(chan struct{}),
+	}
+	s.ticker.Add(1)
+	go s.progress(interval)
+	return s
+}
+
+// Events returns the receiving side of the stream.
+func (s *Stream) Events() <-chan Event {
+	return s.events
+}
+
+// progress sends progress events until the stream finishes.
+// They are dropped while the reader is behind, since the next one supersedes them,
+// and never fill more than half the buffer, which stays free for the other events.
+func (s *Stream) progress(interval time.Duration) {
+	defer s.ticker.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if len(s.events) >= cap(s.events)/2 {
+				continue
+			}
+			// Once the search is cancelled, the stats may be those of the next search
+			stats := s.stats()
+			if s.ctx.Err() != nil {
+				continue
+			}
+			select {
+			case s.events <- Event{Type: EventProgress, Stats: stats}:
+			default:
+			}
+		}
+	}
+}
+
+// Best reports the closest candidate to pattern found so far.
+func (s *Stream) Best(result Result, pattern *Pattern) {
+	s.send(Event{Type: EventBest, Stats: s.stats(), Result: &result, Pattern: pattern})
+}
+
+// Warn reports a problem the search recovered from, instead of logging it over the progress display.
+func (s *Stream) Warn(err error) {
+	s.send(Event{Type: EventWarning, Stats: s.stats(), Err: err})
+}
+
+// Match reports a matching key.
+func (s *Stream) Match(result Result) {
+	if s.send(Event{Type: EventMatch, Stats: s.stats(), Result: &result}) {
+		s.matched.Store(true)
+	}
+}
+
+// send delivers an event unless the search is cancelled first, and reports whether it did.
+// An event that fits in the buffer is delivered even if the search was just cancelled
+// (e.g. a match found by the step that reached MaxAttempts).
+func (s *Stream) send(event Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+	}
+	select {
+	case s.events <- event:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// Finish ends the stream and closes it: with EventError if err is not nil, with EventNotFound
+// if the search stopped at a limit (see Lifecycle.Begin) before a match, or with EventDone.
+// The terminal event carries stats, the final statistics returned by Lifecycle.End.
+// Only the first call has an effect; Best and Match must not be called after it.
+func (s *Stream) Finish(stats Stats, err error) {
+	s.once.Do(func() {
+		close(s.stop)
+		s.ticker.Wait()
+
+		event := Event{Type: EventDone, Stats: stats}
+		switch cause := context.Cause(s.ctx); {
+		case err != nil:
+			event.Type, event.Err = EventError, err
+		case !s.matched.Load() && errors.Is(cause, ErrNotFound):
+			event.Type, event.Err = EventNotFound, cause
+		}
+		s.events <- event
+		close(s.events)
+	})
+}
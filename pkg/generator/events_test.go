@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// finish runs a stream whose search was cancelled with cause, optionally after a match,
+// and returns its events.
+func finish(cause error, match bool, err error) []Event {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	stream := NewStream(ctx, &Config{}, func() Stats { return Stats{} })
+	if match {
+		stream.Match(Result{Address: "match"})
+	}
+	cancel(cause)
+	stream.Finish(Stats{Attempts: 42}, err)
+
+	var events []Event
+	for event := range stream.Events() {
+		if event.Type != EventProgress {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestStreamFinish(t *testing.T) {
+	limit := fmt.Errorf("%w within 42 attempts", ErrNotFound)
+	failure := errors.New("kernel failed")
+
+	tests := []struct {
+		name  string
+		cause error
+		match bool
+		err   error
+		want  EventType
+	}{
+		{name: "cancelled", cause: context.Canceled, want: EventDone},
+		{name: "limit", cause: limit, want: EventNotFound},
+		{name: "match before the limit", cause: limit, match: true, want: EventDone},
+		{name: "error", cause: limit, err: failure, want: EventError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := finish(tt.cause, tt.match, tt.err)
+			if len(events) == 0 {
+				t.Fatal("no terminal event")
+			}
+			end := events[len(events)-1]
+			if end.Type != tt.want {
+				t.Fatalf("terminal event %s, want %s", end.Type, tt.want)
+			}
+			if end.Stats.Attempts != 42 {
+				t.Errorf("terminal event has %d attempts, want the final 42", end.Stats.Attempts)
+			}
+			switch tt.want {
+			case EventNotFound:
+				if !errors.Is(end.Err, ErrNotFound) || end.Err != tt.cause {
+					t.Errorf("error %v, want the limit %v wrapping ErrNotFound", end.Err, tt.cause)
+				}
+			case EventError:
+				if end.Err != tt.err {
+					t.Errorf("error %v, want %v", end.Err, tt.err)
+				}
+			}
+			if tt.match && events[0].Type != EventMatch {
+				t.Errorf("first event %s, want match", events[0].Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Package filecoin provides Filecoin f1 (secp256k1) vanity address generation support.
+// Address = "f1" + Base32-lower(Blake2b-160(uncompressed pubkey) || Blake2b-32(0x01 || payload)).
+package filecoin
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// ProtocolSecp256k1 is the address protocol byte for secp256k1 (f1) addresses.
+	ProtocolSecp256k1 byte = 0x01
+	// AddressPrefix is the fixed leading part of every mainnet f1 address (network + protocol).
+	AddressPrefix = "f1"
+
+	payloadLen  = 20
+	checksumLen = 4
+)
+
+// base32Lower is RFC 4648 Base32 with a lowercase alphabet and no padding, as used by Filecoin.
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// DeriveAddress derives the f1 address for a secp256k1 public key.
+func DeriveAddress(pubKey *btcec.PublicKey) string {
+	payload := blake2bSum(pubKey.SerializeUncompressed(), payloadLen)
+	checksum := blake2bSum(append([]byte{ProtocolSecp256k1}, payload...), checksumLen)
+	return AddressPrefix + base32Lower.EncodeToString(append(payload, checksum...))
+}
+
+// PrivateKeyToHex returns the raw 32-byte private key as hex.
+func PrivateKeyToHex(privKey *btcec.PrivateKey) string {
+	return hex.EncodeToString(privKey.Serialize())
+}
+
+// lotusKeyInfo is the key format used by `lotus wallet export` / `lotus wallet import`.
+type lotusKeyInfo struct {
+	Type       string
+	PrivateKey []byte // Marshalled as standard Base64
+}
+
+// PrivateKeyToLotus returns the private key in Lotus's export format:
+// hex-encoded JSON {"Type":"secp256k1","PrivateKey":"<base64>"}.
+func PrivateKeyToLotus(privKey *btcec.PrivateKey) string {
+	data, _ := json.Marshal(lotusKeyInfo{
+		Type:       "secp256k1",
+		PrivateKey: privKey.Serialize(),
+	})
+	return hex.EncodeToString(data)
+}
+
+// blake2bSum returns a Blake2b digest of the given size.
+func blake2bSum(data []byte, size int) []byte {
+	h, _ := blake2b.New(size, nil)
+	h.Write(data)
+	return h.Sum(nil)
+}
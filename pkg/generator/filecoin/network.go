@@ -0,0 +1,56 @@
+package filecoin
+
+import (
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Filecoin,
+		Name:        "Filecoin",
+		Title:       "Filecoin (FIL)",
+		Icon:        "⨎",
+		Description: "Base32, f1 prefix",
+		ResultLabel: "FILECOIN ADDRESS",
+		KeyType:     generator.KeySecp256k1,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The last character has only 4 possible values
+			return generator.PatternRules{
+				Alphabet:    generator.Base32Lower,
+				Fixed:       "f1",
+				SuffixChars: []string{LastCharRange},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Filecoin f1 addresses (secp256k1 + Blake2b-160 + Base32)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		// Generate secp256k1 key pair (same as Bitcoin)
+		privKey, pubKey, err := bitcoin.GenerateKeyPair()
+		if err != nil {
+			return 0, nil
+		}
+
+		// f1 = "f1" + Base32(Blake2b-160(uncompressed pubkey) || checksum)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		// `lotus wallet import` takes the hex-encoded JSON key
+		return 1, &generator.Result{
+			Network:    generator.Filecoin,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: PrivateKeyToLotus(privKey),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex)", Value: PrivateKeyToHex(privKey)},
+			},
+		}
+	}, nil
+}
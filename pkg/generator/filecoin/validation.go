@@ -0,0 +1,5 @@
+package filecoin
+
+// LastCharRange lists the characters possible at the end of an address.
+// 24 bytes = 192 bits, so the 39th character carries only 2 bits (plus 3 zero padding bits).
+const LastCharRange = "aiqy"
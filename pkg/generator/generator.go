@@ -1,45 +1,55 @@
 // Package generator defines the interface for vanity address generation.
 // This design allows easy swapping between CPU and GPU implementations,
-// and supports multiple blockchain networks (Ethereum, Solana, Bitcoin).
+// and supports multiple blockchain networks through a registry of network descriptors.
 package generator
 
 import (
 	"context"
+	"time"
 )
 
 // Network represents the blockchain network for address generation.
+// The built-in networks are listed below; each one registers its descriptor (see Register)
+// from its own package, and pkg/generator/networks imports them all.
 type Network int
 
 const (
-	Ethereum Network = iota // Ethereum (secp256k1, Keccak-256, Hex)
-	Solana                  // Solana (Ed25519, Base58)
-	Aptos                   // Aptos (Ed25519, SHA3-256, Hex)
-	Sui                     // Sui (Ed25519, Blake2b-256, Hex)
-	Bitcoin                 // Bitcoin (secp256k1, SHA256+RIPEMD160, Base58/Bech32)
-	Tron                    // Tron (secp256k1, Keccak-256, Base58Check)
+	Ethereum  Network = iota // Ethereum (secp256k1, Keccak-256, Hex)
+	Solana                   // Solana (Ed25519, Base58)
+	Aptos                    // Aptos (Ed25519, SHA3-256, Hex)
+	Sui                      // Sui (Ed25519, Blake2b-256, Hex)
+	Bitcoin                  // Bitcoin (secp256k1, SHA256+RIPEMD160, Base58/Bech32)
+	Tron                     // Tron (secp256k1, Keccak-256, Base58Check)
+	Nostr                    // Nostr (secp256k1, BIP-340 x-only, Bech32 npub)
+	Lightning                // Lightning node ID (secp256k1, compressed pubkey, Hex)
+	Stellar                  // Stellar (Ed25519, StrKey Base32)
+	Algorand                 // Algorand (Ed25519, SHA-512/256 checksum, Base32)
+	XRPL                     // XRP Ledger (secp256k1 or Ed25519, HASH160, Base58Check Ripple alphabet)
+	Substrate                // Polkadot/Substrate (Ed25519, Blake2b-512 checksum, SS58 Base58)
+	Cardano                  // Cardano Shelley (Ed25519, Blake2b-224, Bech32 addr1)
+	NEAR                     // NEAR implicit account (Ed25519, Hex public key)
+	Filecoin                 // Filecoin f1 (secp256k1, Blake2b-160, Base32 lowercase)
+	TON                      // TON wallet (Ed25519, StateInit cell hash, Base64url)
+	Monero                   // Monero standard address (Ed25519 spend/view keys, Keccak checksum, Monero Base58)
+	ICP                      // Internet Computer principal (Ed25519 DER, SHA-224, CRC32 + Base32 with dashes)
+	Tor                      // Tor v3 onion service (Ed25519, SHA3-256 checksum, Base32 + .onion)
+	SSH                      // OpenSSH Ed25519 key (SHA256 fingerprint or Base64 public key)
+	OpenPGP                  // OpenPGP v4 Ed25519 key (SHA-1 fingerprint over key + creation time, Hex)
+	X25519                   // X25519 key (WireGuard Base64 or age Bech32 recipient)
+	Libp2p                   // libp2p peer ID (Ed25519, identity multihash of protobuf key, Base58btc)
+	DIDKey                   // did:key (Ed25519, multicodec-prefixed key, multibase Base58btc)
 )
 
-// String returns the network name.
+// String returns the network name from its registered descriptor.
 func (n Network) String() string {
-	switch n {
-	case Ethereum:
-		return "Ethereum"
-	case Solana:
-		return "Solana"
-	case Aptos:
-		return "Aptos"
-	case Sui:
-		return "Sui"
-	case Bitcoin:
-		return "Bitcoin"
-	case Tron:
-		return "Tron"
-	default:
-		return "Unknown"
+	if info, ok := Lookup(n); ok {
+		return info.Name
 	}
+	return "Unknown"
 }
 
-// AddressType represents the Bitcoin address format.
+// AddressType represents the address or key format variant within a network
+// (e.g. Bitcoin P2TR vs P2PKH, Lightning hsm_secret vs plain node key).
 type AddressType int
 
 const (
@@ -47,6 +57,19 @@ const (
 	AddressTypeTaproot                         // P2TR - Taproot (bc1p...) - Recommended
 	AddressTypeLegacy                          // P2PKH - Legacy (1...)
 	AddressTypeNestedSegWit                    // P2SH-P2WPKH - Nested SegWit (3...)
+	AddressTypeHSMSecret                       // Lightning: node key derived from a Core Lightning hsm_secret
+	AddressTypeNodeKey                         // Lightning: plain compressed secp256k1 node key
+	AddressTypeSecp256k1                       // XRPL: secp256k1 account key (family seed s...)
+	AddressTypeEd25519                         // XRPL: Ed25519 account key (family seed sEd...)
+	AddressTypeEnterprise                      // Cardano: enterprise address, payment key only (addr1v...)
+	AddressTypeBase                            // Cardano: base address with a fixed stake key hash (addr1q...)
+	AddressTypeWalletV4R2                      // TON: wallet v4R2 contract
+	AddressTypeWalletV5R1                      // TON: wallet v5R1 (W5) contract
+	AddressTypeFingerprint                     // SSH/OpenPGP: match the key fingerprint
+	AddressTypePublicKey                       // SSH: match the Base64 public key text
+	AddressTypeKeyID                           // OpenPGP: match the long key ID (last 16 hex of the fingerprint)
+	AddressTypeWireGuard                       // X25519: WireGuard public key (Base64)
+	AddressTypeAge                             // X25519: age recipient (age1...)
 )
 
 // String returns the address type name.
@@ -58,6 +81,32 @@ func (a AddressType) String() string {
 		return "Legacy (P2PKH)"
 	case AddressTypeNestedSegWit:
 		return "Nested SegWit (P2SH)"
+	case AddressTypeHSMSecret:
+		return "Core Lightning (hsm_secret)"
+	case AddressTypeNodeKey:
+		return "Node Key (compressed pubkey)"
+	case AddressTypeSecp256k1:
+		return "secp256k1"
+	case AddressTypeEd25519:
+		return "Ed25519"
+	case AddressTypeEnterprise:
+		return "Enterprise (addr1v)"
+	case AddressTypeBase:
+		return "Base (addr1q)"
+	case AddressTypeWalletV4R2:
+		return "Wallet v4R2"
+	case AddressTypeWalletV5R1:
+		return "Wallet v5R1"
+	case AddressTypeFingerprint:
+		return "Fingerprint"
+	case AddressTypePublicKey:
+		return "Public Key"
+	case AddressTypeKeyID:
+		return "Long Key ID"
+	case AddressTypeWireGuard:
+		return "WireGuard"
+	case AddressTypeAge:
+		return "age"
 	default:
 		return "Default"
 	}
@@ -65,38 +114,92 @@ func (a AddressType) String() string {
 
 // Config holds the configuration for vanity address generation.
 type Config struct {
-	Network     Network     // Target network (Ethereum, Solana, Bitcoin)
-	AddressType AddressType // Address type (for Bitcoin: P2TR, P2PKH, P2SH)
-	Prefix      string      // Desired address prefix
-	Suffix      string      // Desired address suffix
-	Contains    string      // Pattern to find anywhere in the address (not overlapping prefix/suffix)
-	Workers     int         // Number of concurrent workers
+	Network     Network           // Target network (Ethereum, Solana, Bitcoin)
+	AddressType AddressType       // Address type (for Bitcoin: P2TR, P2PKH, P2SH; for Lightning: hsm_secret, node key; for XRPL: key type; for Cardano: enterprise, base; for TON: wallet version; for SSH/OpenPGP: matched text; for X25519: key format)
+	Prefix      string            // Desired address prefix
+	Suffix      string            // Desired address suffix
+	Contains    string            // Pattern to find anywhere in the address (not overlapping prefix/suffix)
+	Workers     int               // Number of concurrent workers
+	Options     map[string]string // Network settings by name (see NetworkInfo.Options), e.g. "ss58": "2" for Kusama
+
+	ProgressInterval time.Duration // How often progress events are sent (0 = DefaultProgressInterval)
+
+	// Search limits; a search that reaches one without a match ends with EventNotFound
+	MaxAttempts uint64        // Stop after this many attempts (0 = no limit)
+	MaxDuration time.Duration // Stop after searching this long (0 = no limit)
+	Deadline    time.Time     // Stop at this time (zero = no deadline)
+}
+
+// Option returns the value of a network option, or "" if it is unset.
+func (c *Config) Option(name string) string {
+	return c.Options[name]
+}
+
+// KeyExport is an additional representation of a found key,
+// shown and saved alongside the primary private key (e.g. hex next to nsec).
+type KeyExport struct {
+	Label string // Human-readable format name (e.g. "Private Key (hex)")
+	Value string // Encoded key material
+}
+
+// KeyFile is a ready-to-use key file for the found key (e.g. Core Lightning's hsm_secret).
+type KeyFile struct {
+	Name string // File name, relative to the result's output directory
+	Data []byte // Raw file contents
 }
 
 // Result contains a successfully found vanity address and its private key.
 type Result struct {
-	Network    Network // Network the address belongs to
-	Address    string  // Formatted address (0x... for ETH, Base58 for SOL)
-	PrivateKey string  // Private key (Hex for ETH, Base58 for SOL)
+	Network    Network     // Network the address belongs to
+	Address    string      // Formatted address (0x... for ETH, Base58 for SOL)
+	PrivateKey string      // Private key (Hex for ETH, Base58 for SOL)
+	Exports    []KeyExport // Additional key formats, if the network has any
+	Files      []KeyFile   // Key files to write, if the network has any
+	Partial    int         // Non-zero for a best candidate that does not match: pattern characters it matches
 }
 
 // Stats holds real-time performance statistics.
 type Stats struct {
-	Attempts    uint64  // Total number of addresses generated
-	HashRate    float64 // Current hashes per second
-	ElapsedSecs float64 // Time elapsed since start
+	Attempts       uint64  // Total number of addresses generated
+	HashRate       float64 // Average hashes per second since start
+	RecentHashRate float64 // Hashes per second over the last seconds (see RateMeter); 0 until measured
+	ElapsedSecs    float64 // Time elapsed since start
+
+	// Odds of the current pattern; a pattern change starts them over
+	Difficulty  uint64  // Expected attempts per match (0 = unknown)
+	Probability float64 // Chance of a match within the attempts so far (see Probability and TimeTo)
 }
 
 // Generator defines the contract for address generation backends.
 // Implementations can be CPU-based (goroutines) or GPU-based (CUDA/OpenCL).
 type Generator interface {
-	// Start begins the vanity address search with the given configuration.
-	// It returns a channel that will receive the result when found.
-	// The search can be cancelled via the context.
-	Start(ctx context.Context, config *Config) (<-chan Result, error)
+	// Start begins the vanity address search with the given configuration
+	// and returns its event stream: progress, best candidates and the match.
+	// The search stops after the first match, when the context is cancelled or when it
+	// reaches a limit of the configuration (MaxAttempts, MaxDuration, Deadline).
+	// The stream always ends with one terminal event (EventDone, EventNotFound or EventError)
+	// and is then closed; callers must read it until it is closed.
+	// Configuration errors are returned by Start itself.
+	// A previous search is stopped, and Start waits until it has fully stopped
+	// before the new one begins.
+	Start(ctx context.Context, config *Config) (<-chan Event, error)
+
+	// UpdatePattern replaces the prefix, suffix and contains patterns of the running search
+	// without restarting it: workers and GPU kernels keep running and the stats keep counting.
+	// The patterns are validated with the same network rules; on error the search is unchanged.
+	// It takes effect on the next step of each CPU worker, or the next GPU batch.
+	UpdatePattern(prefix, suffix, contains string) error
+
+	// Wait blocks until the last search has fully stopped: its workers or GPU loop
+	// have exited. Its stream may still be delivering the final events.
+	Wait()
+
+	// Close stops any running search, waits for it and releases the backend's resources
+	// (e.g. OpenCL contexts). Start returns ErrClosed afterwards. Calling it again does nothing.
+	Close() error
 
-	// Stats returns the current performance statistics.
-	// This method is safe to call concurrently from any goroutine.
+	// Stats returns the performance statistics of the current search, or of the last one
+	// once it has stopped. This method is safe to call concurrently from any goroutine.
 	Stats() Stats
 
 	// Name returns the implementation name (e.g., "CPU", "CUDA", "OpenCL").
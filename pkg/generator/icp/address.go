@@ -0,0 +1,89 @@
+// Package icp provides Internet Computer (ICP) self-authenticating principal vanity generation support.
+// Principal = SHA-224(DER-encoded Ed25519 pubkey) || 0x02, shown as
+// Base32-lower(CRC32(principal) || principal) grouped into 5-character chunks with dashes.
+package icp
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"hash/crc32"
+	"strings"
+)
+
+const (
+	// SelfAuthenticatingTag is the trailing type byte of self-authenticating principals.
+	SelfAuthenticatingTag byte = 0x02
+	// GroupSize is the number of characters between dashes in the textual form.
+	GroupSize = 5
+	// IdentityFile is the file name dfx uses for an identity's key (~/.config/dfx/identity/<name>/identity.pem).
+	IdentityFile = "identity.pem"
+)
+
+// ed25519SPKIPrefix is the DER SubjectPublicKeyInfo header for a raw 32-byte Ed25519 key (RFC 8410).
+var ed25519SPKIPrefix = []byte{0x30, 0x2a, 0x30, 0x05, 0x06, 0x03, 0x2b, 0x65, 0x70, 0x03, 0x21, 0x00}
+
+// base32Lower is RFC 4648 Base32 with a lowercase alphabet and no padding, as used by ICP.
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// PublicKeyToDER wraps a raw Ed25519 public key in its DER SubjectPublicKeyInfo encoding.
+func PublicKeyToDER(pubKey []byte) []byte {
+	return append(append([]byte{}, ed25519SPKIPrefix...), pubKey...)
+}
+
+// DerivePrincipal returns the raw 29-byte self-authenticating principal for an Ed25519 public key.
+func DerivePrincipal(pubKey []byte) []byte {
+	hash := sha256.Sum224(PublicKeyToDER(pubKey))
+	return append(hash[:], SelfAuthenticatingTag)
+}
+
+// EncodeCompact returns the textual form of a principal without dashes.
+// Patterns are matched on this form so that patterns can ignore the grouping.
+func EncodeCompact(principal []byte) string {
+	data := binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(principal))
+	return base32Lower.EncodeToString(append(data, principal...))
+}
+
+// Group inserts a dash after every GroupSize characters.
+func Group(compact string) string {
+	var b strings.Builder
+	for i := 0; i < len(compact); i += GroupSize {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(compact[i:min(i+GroupSize, len(compact))])
+	}
+	return b.String()
+}
+
+// Ungroup removes the dashes from a textual principal or pattern.
+func Ungroup(s string) string {
+	return strings.ReplaceAll(s, "-", "")
+}
+
+// DeriveAddress returns the dashed textual principal ID for an Ed25519 public key.
+func DeriveAddress(pubKey []byte) string {
+	return Group(EncodeCompact(DerivePrincipal(pubKey)))
+}
+
+// PublicKeyToHex returns the DER-encoded public key as hex.
+func PublicKeyToHex(pubKey []byte) string {
+	return hex.EncodeToString(PublicKeyToDER(pubKey))
+}
+
+// IdentityPEM returns a dfx-compatible Ed25519 identity (PKCS#8 v2 with the public key,
+// as written by `dfx identity new --storage-mode plaintext`).
+func IdentityPEM(seed, pubKey []byte) []byte {
+	der := []byte{
+		0x30, 0x53, // SEQUENCE
+		0x02, 0x01, 0x01, // version 1 (OneAsymmetricKey)
+		0x30, 0x05, 0x06, 0x03, 0x2b, 0x65, 0x70, // AlgorithmIdentifier Ed25519
+		0x04, 0x22, 0x04, 0x20, // privateKey OCTET STRING { OCTET STRING seed }
+	}
+	der = append(der, seed...)
+	der = append(der, 0xa1, 0x23, 0x03, 0x21, 0x00) // [1] publicKey BIT STRING
+	der = append(der, pubKey...)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
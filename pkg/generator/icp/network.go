@@ -0,0 +1,60 @@
+package icp
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.ICP,
+		Name:        "ICP",
+		Title:       "Internet Computer (ICP)",
+		Icon:        "∞",
+		Description: "Base32 principal, dashed",
+		ResultLabel: "ICP PRINCIPAL ID",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// Patterns match the dash-free form; the trailing 0x02 tag fixes the last characters
+			return generator.PatternRules{
+				Alphabet:    generator.Base32Lower,
+				Normalize:   Ungroup,
+				SuffixChars: []string{LastChar, PenultimateCharRange},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Internet Computer principal IDs (Ed25519 + SHA-224 + CRC32/Base32)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Match on the dash-free form; dashes are only added for display
+		compact := EncodeCompact(DerivePrincipal(pubKey))
+
+		partial, ok := pattern.Check(compact)
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.ICP,
+			Partial:    partial,
+			Address:    Group(compact),
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Public Key (DER hex)", Value: PublicKeyToHex(pubKey)},
+			},
+			Files: []generator.KeyFile{
+				{Name: IdentityFile, Data: IdentityPEM(privKey.Seed(), pubKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,8 @@
+package icp
+
+// The principal ends with the 0x02 tag and 33 bytes = 264 bits leave one padding bit,
+// so the last two characters of a self-authenticating principal are "ae" or "qe".
+const (
+	LastChar             = "e"
+	PenultimateCharRange = "aq"
+)
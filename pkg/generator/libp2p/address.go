@@ -0,0 +1,54 @@
+// Package libp2p provides libp2p Ed25519 peer ID vanity generation support.
+// PeerID = Base58btc(identity multihash(protobuf PublicKey{Type: Ed25519, Data: pubkey})),
+// which always starts with "12D3KooW".
+package libp2p
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// KeyTypeEd25519 is the libp2p KeyType enum value for Ed25519 keys.
+	KeyTypeEd25519 = 1
+	// PeerIDPrefix is the fixed leading part of every Ed25519 peer ID.
+	PeerIDPrefix = "12D3KooW"
+	// PrivateKeyFile is the file name of the protobuf-encoded private key written next to the result.
+	PrivateKeyFile = "identity.key"
+
+	identityMultihash = 0x00
+)
+
+// marshalKey encodes a libp2p PublicKey/PrivateKey protobuf message:
+// field 1 (Type, varint) and field 2 (Data, bytes).
+func marshalKey(keyType byte, data []byte) []byte {
+	out := []byte{0x08, keyType, 0x12, byte(len(data))}
+	return append(out, data...)
+}
+
+// MarshalPublicKey returns the protobuf-encoded public key.
+func MarshalPublicKey(pubKey []byte) []byte {
+	return marshalKey(KeyTypeEd25519, pubKey)
+}
+
+// MarshalPrivateKey returns the protobuf-encoded private key (32-byte seed || 32-byte public key),
+// as read by crypto.UnmarshalPrivateKey in go-libp2p.
+func MarshalPrivateKey(privKey ed25519.PrivateKey) []byte {
+	return marshalKey(KeyTypeEd25519, privKey)
+}
+
+// PrivateKeyToBase64 returns the protobuf-encoded private key in Base64,
+// the form used by the Identity.PrivKey field of a Kubo (IPFS) config.
+func PrivateKeyToBase64(privKey ed25519.PrivateKey) string {
+	return base64.StdEncoding.EncodeToString(MarshalPrivateKey(privKey))
+}
+
+// DerivePeerID returns the peer ID for an Ed25519 public key.
+// Keys this short are inlined with the identity hash instead of SHA-256.
+func DerivePeerID(pubKey []byte) string {
+	key := MarshalPublicKey(pubKey)
+	mh := append([]byte{identityMultihash, byte(len(key))}, key...)
+	return base58.Encode(mh)
+}
@@ -0,0 +1,65 @@
+package libp2p
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Libp2p,
+		Name:        "libp2p",
+		Title:       "libp2p peer ID",
+		Icon:        "🕸",
+		Description: "Base58, 12D3KooW prefix",
+		ResultLabel: "LIBP2P PEER ID",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The fixed key type bytes limit the range of the following characters
+			return generator.PatternRules{
+				Alphabet:    generator.Base58,
+				Fixed:       PeerIDPrefix,
+				PrefixChars: []string{NextCharRange()},
+				CheckPrefix: func(prefix string) error {
+					if !IsValidPrefix(prefix) {
+						return &generator.PatternError{Msg: "prefix is outside the range of possible peer IDs"}
+					}
+					return nil
+				},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for libp2p peer IDs (Ed25519 + protobuf key + identity multihash + Base58btc)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		peerID := DerivePeerID(pubKey)
+
+		partial, ok := pattern.Check(peerID[len(PeerIDPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.Libp2p,
+			Partial:    partial,
+			Address:    peerID,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (protobuf, Base64 for Kubo Identity.PrivKey)", Value: PrivateKeyToBase64(privKey)},
+			},
+			Files: []generator.KeyFile{
+				{Name: PrivateKeyFile, Data: MarshalPrivateKey(privKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,24 @@
+package libp2p
+
+import (
+	"bytes"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// Smallest and largest peer IDs, from the all-zero and all-0xff public keys.
+// Every Ed25519 peer ID has the same length, so they bound the possible prefixes.
+var (
+	minPeerID = DerivePeerID(bytes.Repeat([]byte{0x00}, 32))
+	maxPeerID = DerivePeerID(bytes.Repeat([]byte{0xff}, 32))
+)
+
+// IsValidPrefix checks that a prefix (matched after PeerIDPrefix) can occur.
+func IsValidPrefix(prefix string) bool {
+	return generator.IsValidPrefixRange(PeerIDPrefix, minPeerID, maxPeerID, prefix)
+}
+
+// NextCharRange returns the characters possible right after PeerIDPrefix.
+func NextCharRange() string {
+	return generator.PrefixRangeChars(PeerIDPrefix, minPeerID, maxPeerID)
+}
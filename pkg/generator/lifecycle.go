@@ -0,0 +1,220 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrClosed is returned by Start after the generator is closed.
+var ErrClosed = errors.New("generator is closed")
+
+// Lifecycle is the search bookkeeping shared by the backends. It counts attempts,
+// times the current search, holds its pattern and makes sure a search has fully stopped
+// before the next one starts or the backend is closed.
+// The zero value is ready to use, and every method is safe for concurrent use.
+type Lifecycle struct {
+	attempts atomic.Uint64
+	pattern  atomic.Pointer[Pattern] // Pattern of the current search, replaced by UpdatePattern
+
+	// Set by Begin before the search's goroutines start
+	cancel      context.CancelCauseFunc // Cancels the running search
+	maxAttempts uint64                  // Config.MaxAttempts of the running search
+
+	mu      sync.Mutex
+	start   time.Time     // When the last search started
+	stop    time.Time     // When the last search stopped; zero while it runs
+	done    chan struct{} // Closed when the last search has stopped
+	rate    RateMeter
+	since   uint64 // Attempts when the pattern was last set, which its probability starts from
+	running bool
+	closed  bool
+}
+
+// Begin stops the previous search, waits until it has fully stopped and starts a new one
+// for pattern with zero attempts. The returned context is cancelled with ctx, by Stop and by Close,
+// and when the search reaches a limit of config: its cause then wraps ErrNotFound, which
+// Stream.Finish reports as EventNotFound.
+// The backend must call End once every goroutine of the search has exited.
+// Begin returns ErrClosed after Close.
+func (l *Lifecycle) Begin(ctx context.Context, config *Config, pattern *Pattern) (context.Context, error) {
+	l.mu.Lock()
+	for l.running {
+		cancel, done := l.cancel, l.done
+		l.mu.Unlock()
+		cancel(nil)
+		<-done
+		l.mu.Lock()
+	}
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil, ErrClosed
+	}
+
+	// The earliest of Deadline and MaxDuration stops the search
+	now := time.Now()
+	var deadline time.Time
+	var cause error
+	if !config.Deadline.IsZero() {
+		deadline = config.Deadline
+		cause = fmt.Errorf("%w before the deadline (%s)", ErrNotFound, deadline.Format(time.DateTime))
+	}
+	if end := now.Add(config.MaxDuration); config.MaxDuration > 0 && (deadline.IsZero() || end.Before(deadline)) {
+		deadline = end
+		cause = fmt.Errorf("%w within %s", ErrNotFound, config.MaxDuration)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	l.cancel = cancel
+	if !deadline.IsZero() {
+		var stop context.CancelFunc
+		ctx, stop = context.WithDeadlineCause(ctx, deadline, cause)
+		l.cancel = func(err error) {
+			cancel(err)
+			stop()
+		}
+	}
+	l.maxAttempts = config.MaxAttempts
+
+	l.done = make(chan struct{})
+	l.running = true
+	l.attempts.Store(0)
+	l.pattern.Store(pattern)
+	l.start, l.stop = now, time.Time{}
+	l.rate.Reset()
+	l.rate.Sample(0, now)
+	l.since = 0
+	return ctx, nil
+}
+
+// End marks the current search as stopped and returns its final statistics, for Stream.Finish:
+// once End returns, Stats may already be those of the next search.
+// They stay available from Stats until the next Begin.
+func (l *Lifecycle) End() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.running {
+		l.cancel(nil)
+		l.stop = time.Now()
+		l.running = false
+		close(l.done)
+	}
+	return l.statsLocked()
+}
+
+// Pattern returns the pattern of the current search. Workers load it on every step,
+// and GPU loops before every batch, so that UpdatePattern takes effect while they run.
+func (l *Lifecycle) Pattern() *Pattern {
+	return l.pattern.Load()
+}
+
+// UpdatePattern replaces the pattern of the running search with new prefix, suffix and
+// contains patterns, validated with the same rules. Attempts and time keep counting;
+// the probability of a match starts over, since earlier attempts were checked against the old pattern.
+func (l *Lifecycle) UpdatePattern(prefix, suffix, contains string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.running {
+		return fmt.Errorf("no search is running")
+	}
+	pattern, err := l.pattern.Load().Replace(prefix, suffix, contains)
+	if err != nil {
+		return err
+	}
+	l.pattern.Store(pattern)
+	l.since = l.attempts.Load()
+	return nil
+}
+
+// Add counts attempts of the current search, and stops it when they reach Config.MaxAttempts.
+func (l *Lifecycle) Add(attempts uint64) {
+	total := l.attempts.Add(attempts)
+	if l.maxAttempts > 0 && total >= l.maxAttempts && total-attempts < l.maxAttempts {
+		l.cancel(fmt.Errorf("%w within %d attempts", ErrNotFound, l.maxAttempts))
+	}
+}
+
+// Stats returns the statistics of the current search, or of the last one once it has stopped.
+func (l *Lifecycle) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.statsLocked()
+}
+
+// statsLocked returns the statistics of the current or last search. l.mu must be held.
+func (l *Lifecycle) statsLocked() Stats {
+	attempts := l.attempts.Load()
+	var elapsed float64
+	var recent float64
+	switch {
+	case l.start.IsZero():
+	case l.stop.IsZero():
+		now := time.Now()
+		elapsed = now.Sub(l.start).Seconds()
+		recent = l.rate.Sample(attempts, now)
+	default:
+		elapsed = l.stop.Sub(l.start).Seconds()
+		recent = l.rate.Sample(attempts, l.stop)
+	}
+
+	var hashRate float64
+	if elapsed > 0 {
+		hashRate = float64(attempts) / elapsed
+	}
+	stats := Stats{
+		Attempts:       attempts,
+		HashRate:       hashRate,
+		RecentHashRate: recent,
+		ElapsedSecs:    elapsed,
+	}
+	if pattern := l.pattern.Load(); pattern != nil {
+		stats.Difficulty = pattern.Difficulty()
+		stats.Probability = Probability(attempts-l.since, stats.Difficulty)
+	}
+	return stats
+}
+
+// Stop cancels the current search without waiting for it.
+func (l *Lifecycle) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.running {
+		l.cancel(nil)
+	}
+}
+
+// Wait blocks until the last search has fully stopped.
+func (l *Lifecycle) Wait() {
+	l.mu.Lock()
+	done := l.done
+	l.mu.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+// Close stops the current search, waits for it and makes later Begin calls fail.
+// It reports whether this call closed the lifecycle, so that the backend releases
+// its resources only once.
+func (l *Lifecycle) Close() bool {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return false
+	}
+	l.closed = true
+	l.mu.Unlock()
+
+	l.Stop()
+	l.Wait()
+	return true
+}
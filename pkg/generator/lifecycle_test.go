@@ -0,0 +1,219 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// search runs a fake backend search on l until it is cancelled, counting attempts
+// like a worker, and returns a channel that receives its final stats once it has ended.
+func search(t *testing.T, l *Lifecycle, config *Config) <-chan Stats {
+	t.Helper()
+	ctx, err := l.Begin(context.Background(), config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	final := make(chan Stats, 1)
+	go func() {
+		for ctx.Err() == nil {
+			l.Add(1)
+			time.Sleep(time.Millisecond)
+		}
+		final <- l.End()
+	}()
+	return final
+}
+
+func TestLifecycleBeginWaitsForPreviousSearch(t *testing.T) {
+	var l Lifecycle
+	var ended atomic.Bool
+	ctx, err := l.Begin(context.Background(), &Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		<-ctx.Done()
+		// The search takes a while to drain after it is cancelled
+		time.Sleep(50 * time.Millisecond)
+		ended.Store(true)
+		l.End()
+	}()
+
+	next, err := l.Begin(context.Background(), &Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ended.Load() {
+		t.Fatal("Begin returned before the previous search ended")
+	}
+	if next.Err() != nil {
+		t.Fatal("new search is already cancelled")
+	}
+	if stats := l.Stats(); stats.Attempts != 0 {
+		t.Errorf("new search starts with %d attempts, want 0", stats.Attempts)
+	}
+	l.End()
+}
+
+func TestLifecycleStatsDuringBeginAndEnd(t *testing.T) {
+	var l Lifecycle
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if stats := l.Stats(); stats.ElapsedSecs < 0 || stats.HashRate < 0 {
+				t.Errorf("invalid stats %+v", stats)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 5; i++ {
+		final := search(t, &l, &Config{})
+		time.Sleep(5 * time.Millisecond)
+		l.Stop()
+		<-final
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestLifecycleEndReturnsFinalStats(t *testing.T) {
+	var l Lifecycle
+	final := search(t, &l, &Config{})
+	time.Sleep(20 * time.Millisecond)
+
+	// A new search stops the first one, whose final stats stay its own
+	next := search(t, &l, &Config{})
+	stats := <-final
+	if stats.Attempts == 0 || stats.ElapsedSecs < 0.02 {
+		t.Errorf("final stats %+v, want those of the first search", stats)
+	}
+	l.Stop()
+	<-next
+}
+
+func TestLifecycleCloseTwice(t *testing.T) {
+	var l Lifecycle
+	final := search(t, &l, &Config{})
+
+	if !l.Close() {
+		t.Error("first Close reported the lifecycle already closed")
+	}
+	select {
+	case <-final:
+	case <-time.After(time.Second):
+		t.Error("search did not end after Close")
+	}
+	if l.Close() {
+		t.Error("second Close reported closing the lifecycle again")
+	}
+
+	if _, err := l.Begin(context.Background(), &Config{}, nil); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Begin after Close returned %v, want ErrClosed", err)
+	}
+}
+
+func TestLifecycleWaitWithoutSearch(t *testing.T) {
+	var l Lifecycle
+	done := make(chan struct{})
+	go func() {
+		l.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Wait blocked without a search")
+	}
+}
+
+func TestLifecycleMaxAttempts(t *testing.T) {
+	var l Lifecycle
+	ctx, err := l.Begin(context.Background(), &Config{MaxAttempts: 10}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.End()
+
+	l.Add(9)
+	if ctx.Err() != nil {
+		t.Fatal("search stopped before reaching MaxAttempts")
+	}
+	// A batch that crosses the limit stops the search
+	l.Add(5)
+	if ctx.Err() == nil {
+		t.Fatal("search still running after crossing MaxAttempts")
+	}
+	cause := context.Cause(ctx)
+	if !errors.Is(cause, ErrNotFound) {
+		t.Fatalf("cause %v does not wrap ErrNotFound", cause)
+	}
+	if want := "no match found within 10 attempts"; cause.Error() != want {
+		t.Errorf("cause %q, want %q", cause, want)
+	}
+}
+
+func TestLifecycleDeadline(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{
+			name:   "deadline",
+			config: Config{Deadline: time.Now().Add(20 * time.Millisecond)},
+			want:   "no match found before the deadline",
+		},
+		{
+			name:   "max duration",
+			config: Config{MaxDuration: 20 * time.Millisecond},
+			want:   "no match found within 20ms",
+		},
+		{
+			name:   "max duration before deadline",
+			config: Config{MaxDuration: 20 * time.Millisecond, Deadline: time.Now().Add(time.Hour)},
+			want:   "no match found within 20ms",
+		},
+		{
+			name:   "deadline before max duration",
+			config: Config{MaxDuration: time.Hour, Deadline: time.Now().Add(20 * time.Millisecond)},
+			want:   "no match found before the deadline",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l Lifecycle
+			ctx, err := l.Begin(context.Background(), &tt.config, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.End()
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+				t.Fatal("search did not stop")
+			}
+			cause := context.Cause(ctx)
+			if !errors.Is(cause, ErrNotFound) {
+				t.Fatalf("cause %v does not wrap ErrNotFound", cause)
+			}
+			if !strings.HasPrefix(cause.Error(), tt.want) {
+				t.Errorf("cause %q, want it to start with %q", cause, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Package lightning provides Lightning Network node ID vanity generation support.
+// Node IDs are 33-byte compressed secp256k1 public keys shown as hex (02.../03...).
+package lightning
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// HSMSecretSize is the size of a Core Lightning hsm_secret file.
+	HSMSecretSize = 32
+	// HSMSecretFile is the file name Core Lightning expects in its network directory.
+	HSMSecretFile = "hsm_secret"
+	// NodeIDLen is the length of a hex-encoded node ID.
+	NodeIDLen = 66
+)
+
+// GenerateHSMSecret generates a random 32-byte hsm_secret.
+func GenerateHSMSecret() ([]byte, error) {
+	secret := make([]byte, HSMSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// DeriveNodeKey derives the node key from an hsm_secret exactly as Core Lightning does:
+// node_privkey = HKDF-SHA256(ikm=hsm_secret, salt=u32 counter, info="nodeid"),
+// retrying with the next salt until the result is a valid secp256k1 scalar.
+// The salt is a little-endian u32 starting at 0 (the in-memory layout on CLN's supported platforms).
+func DeriveNodeKey(hsmSecret []byte) (*btcec.PrivateKey, *btcec.PublicKey, error) {
+	var salt [4]byte
+	key := make([]byte, 32)
+
+	for counter := uint32(0); ; counter++ {
+		binary.LittleEndian.PutUint32(salt[:], counter)
+		r := hkdf.New(sha256.New, hsmSecret, salt[:], []byte("nodeid"))
+		if _, err := io.ReadFull(r, key); err != nil {
+			return nil, nil, err
+		}
+
+		// Reject zero and scalars >= n, like secp256k1_ec_pubkey_create does
+		var scalar btcec.ModNScalar
+		if overflow := scalar.SetByteSlice(key); overflow || scalar.IsZero() {
+			if counter == ^uint32(0) {
+				return nil, nil, fmt.Errorf("no valid node key for hsm_secret")
+			}
+			continue
+		}
+
+		privKey, pubKey := btcec.PrivKeyFromBytes(key)
+		return privKey, pubKey, nil
+	}
+}
+
+// ParityChars lists the characters possible at the start of a node ID: the compressed
+// public key's parity byte is 02 or 03 (see generator.PatternRules.PrefixChars).
+var ParityChars = []string{"0", "23"}
+
+// NodeID returns the hex-encoded compressed public key (the Lightning node ID).
+func NodeID(pubKey *btcec.PublicKey) string {
+	return hex.EncodeToString(pubKey.SerializeCompressed())
+}
+
+// PrivateKeyToHex returns the raw 32-byte private key as hex.
+func PrivateKeyToHex(privKey *btcec.PrivateKey) string {
+	return hex.EncodeToString(privKey.Serialize())
+}
@@ -0,0 +1,78 @@
+package lightning
+
+import (
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.Lightning,
+		Name:         "Lightning",
+		Title:        "Lightning Node ID",
+		Icon:         "⚡",
+		Description:  "Hex, 02/03 prefix",
+		ResultLabel:  "LIGHTNING NODE ID",
+		KeyType:      generator.KeySecp256k1,
+		AddressTypes: []generator.AddressType{generator.AddressTypeHSMSecret, generator.AddressTypeNodeKey},
+		Rules: func(*generator.Config) generator.PatternRules {
+			// Node IDs start with the 02/03 parity byte, which the prefix includes,
+			// so "02dead" and "03dead" pick the parity and "0" leaves it open
+			return generator.PatternRules{Alphabet: generator.Hex, PrefixChars: ParityChars}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Lightning node IDs (secp256k1 compressed pubkey, Hex).
+// In hsm_secret mode the node key is derived from a random hsm_secret via HKDF,
+// so the result can be dropped straight into a Core Lightning data directory.
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine mode (default to Core Lightning hsm_secret)
+	useHSMSecret := config.AddressType != generator.AddressTypeNodeKey
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		var hsmSecret []byte
+		var privKey *btcec.PrivateKey
+		var pubKey *btcec.PublicKey
+		var err error
+
+		if useHSMSecret {
+			hsmSecret, err = GenerateHSMSecret()
+			if err != nil {
+				return 0, nil
+			}
+			privKey, pubKey, err = DeriveNodeKey(hsmSecret)
+		} else {
+			privKey, pubKey, err = bitcoin.GenerateKeyPair()
+		}
+		if err != nil {
+			return 0, nil
+		}
+
+		nodeID := NodeID(pubKey)
+
+		partial, ok := pattern.Check(nodeID)
+		if !ok {
+			return 1, nil
+		}
+		result := &generator.Result{
+			Network:    generator.Lightning,
+			Partial:    partial,
+			Address:    nodeID,
+			PrivateKey: PrivateKeyToHex(privKey),
+		}
+		if hsmSecret != nil {
+			result.Exports = []generator.KeyExport{
+				{Label: "hsm_secret (hex)", Value: hex.EncodeToString(hsmSecret)},
+			}
+			result.Files = []generator.KeyFile{
+				{Name: HSMSecretFile, Data: hsmSecret},
+			}
+		}
+		return 1, result
+	}, nil
+}
@@ -0,0 +1,33 @@
+// Package monero provides Monero standard address vanity generation support.
+// Address = Monero-Base58(network byte || public spend key || public view key || Keccak-256 checksum[:4]).
+package monero
+
+import (
+	"encoding/hex"
+)
+
+const (
+	// MainnetNetworkByte is the network byte of mainnet standard addresses.
+	MainnetNetworkByte byte = 0x12
+	// AddressPrefix is the fixed leading character of every mainnet standard address.
+	AddressPrefix = "4"
+	// AddressLen is the length of a standard address (69 bytes, block-wise Base58).
+	AddressLen = 95
+
+	checksumLen = 4
+)
+
+// DeriveAddress encodes the public spend and view keys as a mainnet standard address.
+func DeriveAddress(spendPublic, viewPublic []byte) string {
+	data := make([]byte, 0, 1+2*KeySize+checksumLen)
+	data = append(data, MainnetNetworkByte)
+	data = append(data, spendPublic...)
+	data = append(data, viewPublic...)
+	data = append(data, keccak256(data)[:checksumLen]...)
+	return base58Encode(data)
+}
+
+// KeyToHex returns a key as hex, the form the official wallet's restore prompts accept.
+func KeyToHex(key []byte) string {
+	return hex.EncodeToString(key)
+}
@@ -0,0 +1,41 @@
+package monero
+
+import "github.com/Amr-9/HexHunter/pkg/generator"
+
+const (
+	fullBlockSize        = 8
+	fullEncodedBlockSize = 11
+)
+
+// encodedBlockSizes maps a block's byte length to its fixed encoded length.
+var encodedBlockSizes = [fullBlockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// base58Encode encodes data with Monero's block-wise Base58: every 8-byte block
+// becomes exactly 11 characters (left-padded with '1'), so the output length is fixed.
+func base58Encode(data []byte) string {
+	out := make([]byte, 0, (len(data)/fullBlockSize+1)*fullEncodedBlockSize)
+	for i := 0; i < len(data); i += fullBlockSize {
+		end := i + fullBlockSize
+		if end > len(data) {
+			end = len(data)
+		}
+		out = append(out, encodeBlock(data[i:end])...)
+	}
+	return string(out)
+}
+
+// encodeBlock encodes one block of up to 8 bytes as a big-endian number.
+func encodeBlock(block []byte) []byte {
+	size := encodedBlockSizes[len(block)]
+	out := make([]byte, size)
+
+	var num uint64
+	for _, b := range block {
+		num = num<<8 | uint64(b)
+	}
+	for i := size - 1; i >= 0; i-- {
+		out[i] = generator.Base58.Chars[num%58]
+		num /= 58
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package monero
+
+import (
+	"crypto/rand"
+
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/sha3"
+)
+
+// KeySize is the size of Monero private and public keys.
+const KeySize = 32
+
+// Keys holds a Monero account's spend and view key pairs.
+type Keys struct {
+	SpendSecret []byte
+	ViewSecret  []byte
+	SpendPublic []byte
+	ViewPublic  []byte
+}
+
+// GenerateSpendKey returns a random private spend key, reduced mod l
+// (sc_reduce32) so that it round-trips through the 25-word seed.
+func GenerateSpendKey() ([]byte, error) {
+	var wide [64]byte
+	if _, err := rand.Read(wide[:KeySize]); err != nil {
+		return nil, err
+	}
+	s, err := edwards25519.NewScalar().SetUniformBytes(wide[:])
+	if err != nil {
+		return nil, err
+	}
+	return s.Bytes(), nil
+}
+
+// DeriveKeys derives the full key set from a reduced private spend key.
+// The view key is deterministic: view = sc_reduce32(Keccak-256(spend)).
+// Public keys are plain scalar multiples of the base point (no Ed25519 clamping).
+func DeriveKeys(spendSecret []byte) (*Keys, error) {
+	spend, err := edwards25519.NewScalar().SetCanonicalBytes(spendSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	view, err := scReduce32(keccak256(spendSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keys{
+		SpendSecret: spend.Bytes(),
+		ViewSecret:  view.Bytes(),
+		SpendPublic: new(edwards25519.Point).ScalarBaseMult(spend).Bytes(),
+		ViewPublic:  new(edwards25519.Point).ScalarBaseMult(view).Bytes(),
+	}, nil
+}
+
+// scReduce32 reduces a 32-byte little-endian value mod l.
+func scReduce32(b []byte) (*edwards25519.Scalar, error) {
+	var wide [64]byte
+	copy(wide[:], b)
+	return edwards25519.NewScalar().SetUniformBytes(wide[:])
+}
+
+// keccak256 is the original Keccak-256 (not SHA3-256), Monero's cn_fast_hash.
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,46 @@
+package monero
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"hash/crc32"
+	"strings"
+)
+
+//go:embed wordlist/english.txt
+var englishWordlist string
+
+// wordlist is Monero's English Electrum-style wordlist (1626 words).
+var wordlist = strings.Split(strings.TrimSpace(englishWordlist), "\n")
+
+// uniquePrefixLen is how many leading characters identify an English word;
+// the checksum word is chosen from these prefixes.
+const uniquePrefixLen = 3
+
+// SpendKeyToMnemonic converts a reduced private spend key to the 25-word seed
+// used by the official wallet: every 4 bytes (little-endian) become 3 words,
+// and the 25th word repeats one of the first 24, chosen by a CRC32 checksum.
+func SpendKeyToMnemonic(spendSecret []byte) string {
+	n := uint32(len(wordlist))
+	words := make([]string, 0, 25)
+
+	for i := 0; i+4 <= len(spendSecret); i += 4 {
+		x := binary.LittleEndian.Uint32(spendSecret[i : i+4])
+		w1 := x % n
+		w2 := (x/n + w1) % n
+		w3 := (x/n/n + w2) % n
+		words = append(words, wordlist[w1], wordlist[w2], wordlist[w3])
+	}
+
+	var prefixes strings.Builder
+	for _, w := range words {
+		if len(w) > uniquePrefixLen {
+			w = w[:uniquePrefixLen]
+		}
+		prefixes.WriteString(w)
+	}
+	checksum := crc32.ChecksumIEEE([]byte(prefixes.String()))
+	words = append(words, words[checksum%uint32(len(words))])
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,79 @@
+package monero
+
+import (
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Monero,
+		Name:        "Monero",
+		Title:       "Monero (XMR)",
+		Icon:        "ɱ",
+		Description: "Base58, 4 prefix",
+		ResultLabel: "MONERO ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// Block-wise Base58 pins the first character of every 11-character block
+			return generator.PatternRules{
+				Alphabet:    generator.Base58,
+				Fixed:       AddressPrefix,
+				PrefixChars: []string{SecondCharRange},
+				CheckPrefix: func(prefix string) error {
+					if !IsValidPrefix(prefix) {
+						return &generator.PatternError{
+							Msg:  "a character at an 11-character block boundary is out of range",
+							Hint: "Other characters are not possible at this position",
+						}
+					}
+					return nil
+				},
+				CheckSuffix: func(suffix string) error {
+					if !IsValidSuffix(suffix) {
+						return &generator.PatternError{
+							Msg:  "the 7th character from the end must be 1-9 or A-V, and earlier block boundaries 1-9, A-j",
+							Hint: "Other characters are not possible at this position",
+						}
+					}
+					return nil
+				},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Monero standard addresses (Ed25519 spend/view keys + Keccak + block Base58)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		spendSecret, err := GenerateSpendKey()
+		if err != nil {
+			return 0, nil
+		}
+
+		// View key is derived from the spend key, so the seed restores both
+		keys, err := DeriveKeys(spendSecret)
+		if err != nil {
+			return 0, nil
+		}
+
+		address := DeriveAddress(keys.SpendPublic, keys.ViewPublic)
+
+		partial, ok := pattern.Check(address[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.Monero,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: SpendKeyToMnemonic(keys.SpendSecret),
+			Exports: []generator.KeyExport{
+				{Label: "Private Spend Key", Value: KeyToHex(keys.SpendSecret)},
+				{Label: "Private View Key", Value: KeyToHex(keys.ViewSecret)},
+				{Label: "Public Spend Key", Value: KeyToHex(keys.SpendPublic)},
+				{Label: "Public View Key", Value: KeyToHex(keys.ViewPublic)},
+			},
+		}
+	}, nil
+}
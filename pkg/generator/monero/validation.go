@@ -0,0 +1,61 @@
+package monero
+
+import (
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// SecondCharRange lists the characters possible right after the leading '4'.
+// The first block is 0x12 followed by 7 key bytes, which only spans "41..." to "4B...".
+const SecondCharRange = "123456789AB"
+
+// Characters possible at the start of a block: a full 8-byte block encodes at most
+// "jpXCZedGfVQ" and the final 5-byte block at most "VtB5VXc".
+const (
+	fullBlockFirstChars = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghij"
+	lastBlockFirstChars = "123456789ABCDEFGHJKLMNPQRSTUV"
+)
+
+// lastBlockStart is the address position where the final 5-byte block begins.
+const lastBlockStart = AddressLen - 7
+
+// CharRange returns the characters possible at a position of the address.
+// Block-wise encoding pins the first character of every block.
+func CharRange(pos int) string {
+	switch {
+	case pos == 0:
+		return AddressPrefix
+	case pos == 1:
+		return SecondCharRange
+	case pos == lastBlockStart:
+		return lastBlockFirstChars
+	case pos%fullEncodedBlockSize == 0:
+		return fullBlockFirstChars
+	default:
+		return generator.Base58.Chars
+	}
+}
+
+// IsValidPrefix checks that a prefix (matched after the leading '4') can occur.
+func IsValidPrefix(prefix string) bool {
+	return fitsAt(prefix, len(AddressPrefix))
+}
+
+// IsValidSuffix checks that a suffix can occur at the end of an address.
+func IsValidSuffix(suffix string) bool {
+	return fitsAt(suffix, AddressLen-len(suffix))
+}
+
+// fitsAt reports whether s fits the per-position character ranges starting at pos.
+func fitsAt(s string, pos int) bool {
+	if pos < 0 {
+		return false
+	}
+	for i, c := range s {
+		if !strings.ContainsRune(CharRange(pos+i), c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+// Package near provides NEAR implicit account vanity generation support.
+// An implicit account ID is the lowercase hex of the 32-byte Ed25519 public key,
+// so patterns follow the same hex rules as Aptos and Sui.
+package near
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/mr-tron/base58"
+)
+
+// KeyPrefix is the curve tag NEAR puts in front of Base58 keys.
+const KeyPrefix = "ed25519:"
+
+// DeriveAddress returns the implicit account ID for an Ed25519 public key.
+func DeriveAddress(pubKey []byte) string {
+	return hex.EncodeToString(pubKey)
+}
+
+// PrivateKeyToString encodes a private key as ed25519:<Base58(seed || pubkey)>,
+// the form used by NEAR CLI and wallets.
+func PrivateKeyToString(privKey ed25519.PrivateKey) string {
+	return KeyPrefix + base58.Encode(privKey)
+}
+
+// PublicKeyToString encodes a public key as ed25519:<Base58(pubkey)>.
+func PublicKeyToString(pubKey []byte) string {
+	return KeyPrefix + base58.Encode(pubKey)
+}
+
+// CredentialsFile returns the NEAR CLI credentials file name for an account,
+// to be placed in ~/.near-credentials/mainnet/.
+func CredentialsFile(accountID string) string {
+	return accountID + ".json"
+}
+
+// credentials is the NEAR CLI credentials JSON format.
+type credentials struct {
+	AccountID  string `json:"account_id"`
+	PublicKey  string `json:"public_key"`
+	PrivateKey string `json:"private_key"`
+}
+
+// CredentialsJSON returns the NEAR CLI credentials file contents for an implicit account.
+func CredentialsJSON(privKey ed25519.PrivateKey) []byte {
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	data, _ := json.Marshal(credentials{
+		AccountID:  DeriveAddress(pubKey),
+		PublicKey:  PublicKeyToString(pubKey),
+		PrivateKey: PrivateKeyToString(privKey),
+	})
+	return data
+}
@@ -0,0 +1,34 @@
+package near
+
+import (
+	"crypto/ed25519"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/Amr-9/HexHunter/pkg/generator/ed25519hex"
+)
+
+// deriveKernel defines derive_address for the shared Ed25519 hex kernel.
+// The implicit account ID is the hex public key itself, so there is no hash stage.
+const deriveKernel = `
+void derive_address(const uchar *public_key, uchar *address) {
+  for (int i = 0; i < 32; i++) {
+    address[i] = public_key[i];
+  }
+}
+`
+
+// newGPUGenerator returns the shared Ed25519 hex GPU engine with the NEAR derivation.
+func newGPUGenerator() (generator.Generator, error) {
+	gen, err := ed25519hex.NewGPUGenerator(ed25519hex.Derivation{
+		Name:   "GPU (NEAR Ed25519)",
+		Kernel: deriveKernel,
+		Result: func(seed []byte) (generator.Result, string) {
+			result := newResult(ed25519.NewKeyFromSeed(seed))
+			return result, result.Address
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
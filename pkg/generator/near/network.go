@@ -0,0 +1,65 @@
+package near
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.NEAR,
+		Name:        "NEAR",
+		Title:       "NEAR (implicit)",
+		Icon:        "Ⓝ",
+		Description: "Hex, 64 chars",
+		ResultLabel: "NEAR IMPLICIT ACCOUNT",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.HexRules("")
+		},
+		NewWorker: newWorker,
+		GPU:       newGPUGenerator,
+	})
+}
+
+// newWorker returns a CPU search step for NEAR implicit accounts (Ed25519, hex public key)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	// Implicit account IDs are plain hex, same as Aptos/Sui addresses
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Implicit account ID = hex(pubkey)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address)
+		if !ok {
+			return 1, nil
+		}
+		result := newResult(privKey)
+		result.Partial = partial
+		return 1, &result
+	}, nil
+}
+
+// newResult returns the result for a key: the implicit account with the private key
+// in NEAR's "ed25519:<base58>" form and a near-cli credentials file.
+func newResult(privKey ed25519.PrivateKey) generator.Result {
+	address := DeriveAddress(privKey.Public().(ed25519.PublicKey))
+	return generator.Result{
+		Network:    generator.NEAR,
+		Address:    address,
+		PrivateKey: PrivateKeyToString(privKey),
+		Exports: []generator.KeyExport{
+			{Label: "Private Key (hex seed)", Value: hex.EncodeToString(privKey.Seed())},
+		},
+		Files: []generator.KeyFile{
+			{Name: CredentialsFile(address), Data: CredentialsJSON(privKey)},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+// Package networks registers every built-in network with the generator registry.
+// Import it for side effects:
+//
+//	import _ "github.com/Amr-9/HexHunter/pkg/generator/networks"
+package networks
+
+import (
+	_ "github.com/Amr-9/HexHunter/pkg/generator/algorand"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/aptos"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/cardano"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/didkey"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/ethereum"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/filecoin"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/icp"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/libp2p"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/lightning"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/monero"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/near"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/nostr"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/openpgp"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/openssh"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/solana"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/stellar"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/substrate"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/sui"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/ton"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/tor"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/tron"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/x25519"
+	_ "github.com/Amr-9/HexHunter/pkg/generator/xrpl"
+)
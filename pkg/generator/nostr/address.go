@@ -0,0 +1,55 @@
+// Package nostr provides Nostr vanity public key generation support.
+// Nostr keys are BIP-340 x-only secp256k1 keys encoded with NIP-19 Bech32 (npub1.../nsec1...).
+package nostr
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+const (
+	// PublicKeyHRP is the Bech32 human-readable part for public keys.
+	PublicKeyHRP = "npub"
+	// PrivateKeyHRP is the Bech32 human-readable part for private keys.
+	PrivateKeyHRP = "nsec"
+	// AddressPrefix is the fixed leading part of every npub (HRP + separator).
+	AddressPrefix = PublicKeyHRP + "1"
+)
+
+// DeriveAddress derives the npub for a secp256k1 public key.
+// npub = Bech32("npub", x-only pubkey) as defined by NIP-19.
+func DeriveAddress(pubKey *btcec.PublicKey) string {
+	return encodeBech32(PublicKeyHRP, schnorr.SerializePubKey(pubKey))
+}
+
+// PrivateKeyToNsec encodes a private key as an nsec string.
+func PrivateKeyToNsec(privKey *btcec.PrivateKey) string {
+	return encodeBech32(PrivateKeyHRP, privKey.Serialize())
+}
+
+// PrivateKeyToHex returns the raw 32-byte private key as hex.
+func PrivateKeyToHex(privKey *btcec.PrivateKey) string {
+	return hex.EncodeToString(privKey.Serialize())
+}
+
+// PublicKeyToHex returns the x-only public key as hex, the form used in Nostr events.
+func PublicKeyToHex(pubKey *btcec.PublicKey) string {
+	return hex.EncodeToString(schnorr.SerializePubKey(pubKey))
+}
+
+// encodeBech32 encodes 32 bytes of key material under the given HRP.
+// NIP-19 uses classic Bech32, not Bech32m.
+func encodeBech32(hrp string, data []byte) string {
+	conv, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return ""
+	}
+	encoded, err := bech32.Encode(hrp, conv)
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
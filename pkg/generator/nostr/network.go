@@ -0,0 +1,51 @@
+package nostr
+
+import (
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Nostr,
+		Name:        "Nostr",
+		Title:       "Nostr (npub)",
+		Icon:        "🟣",
+		Description: "Bech32, npub1 prefix",
+		ResultLabel: "NOSTR PUBLIC KEY",
+		KeyType:     generator.KeySecp256k1,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.PatternRules{Alphabet: generator.Bech32, Fixed: AddressPrefix}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Nostr public keys (secp256k1 x-only + Bech32 npub)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		// Generate secp256k1 key pair (same as Bitcoin)
+		privKey, pubKey, err := bitcoin.GenerateKeyPair()
+		if err != nil {
+			return 0, nil
+		}
+
+		// npub = Bech32("npub", x-only pubkey)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.Nostr,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: PrivateKeyToNsec(privKey),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex)", Value: PrivateKeyToHex(privKey)},
+				{Label: "Public Key (hex)", Value: PublicKeyToHex(pubKey)},
+			},
+		}
+	}, nil
+}
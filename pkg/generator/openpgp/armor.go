@@ -0,0 +1,56 @@
+package openpgp
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// Armored block types.
+const (
+	PrivateKeyBlock = "PGP PRIVATE KEY BLOCK"
+	PublicKeyBlock  = "PGP PUBLIC KEY BLOCK"
+)
+
+// Key file names written next to the result.
+const (
+	SecretKeyFile = "secret-key.asc"
+	PublicKeyFile = "public-key.asc"
+)
+
+// CRC-24 parameters from RFC 4880 section 6.1.
+const (
+	crc24Init = 0xb704ce
+	crc24Poly = 0x1864cfb
+)
+
+// Armor returns data as an ASCII-armored block with a CRC-24 checksum line.
+func Armor(blockType string, data []byte) []byte {
+	var b strings.Builder
+	b.WriteString("-----BEGIN " + blockType + "-----\n\n")
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 64 {
+		b.WriteString(encoded[:64] + "\n")
+		encoded = encoded[64:]
+	}
+	b.WriteString(encoded + "\n")
+
+	crc := crc24(data)
+	b.WriteString("=" + base64.StdEncoding.EncodeToString([]byte{byte(crc >> 16), byte(crc >> 8), byte(crc)}) + "\n")
+	b.WriteString("-----END " + blockType + "-----\n")
+	return []byte(b.String())
+}
+
+// crc24 computes the OpenPGP CRC-24 of data.
+func crc24(data []byte) uint32 {
+	crc := uint32(crc24Init)
+	for _, d := range data {
+		crc ^= uint32(d) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= crc24Poly
+			}
+		}
+	}
+	return crc & 0xffffff
+}
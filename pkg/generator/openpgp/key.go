@@ -0,0 +1,78 @@
+// Package openpgp provides OpenPGP v4 Ed25519 key fingerprint vanity generation support.
+// Fingerprint = SHA-1(0x99 || length || public key packet body), and the body includes
+// the key's creation timestamp, so each key pair can be ground over many timestamps.
+package openpgp
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// PubKeyAlgoEdDSA is the OpenPGP public key algorithm ID for (legacy) EdDSA.
+	PubKeyAlgoEdDSA byte = 22
+	// FingerprintSize is the length of a v4 fingerprint in bytes.
+	FingerprintSize = sha1.Size
+	// KeyIDSize is the length of a long key ID (the last 8 bytes of the fingerprint).
+	KeyIDSize = 8
+
+	// DefaultTimeWindow is how far back (in seconds) creation timestamps are ground by default.
+	DefaultTimeWindow = 365 * 24 * 60 * 60
+)
+
+// ed25519OID is the curve OID 1.3.6.1.4.1.11591.15.1 used by EdDSA keys.
+var ed25519OID = []byte{0x2b, 0x06, 0x01, 0x04, 0x01, 0xda, 0x47, 0x0f, 0x01}
+
+// publicKeyBodyLen is the size of a v4 EdDSA public key packet body.
+const publicKeyBodyLen = 1 + 4 + 1 + 1 + 9 + 2 + 1 + 32
+
+// PublicKeyBody returns the v4 public key packet body for an Ed25519 key.
+func PublicKeyBody(pubKey []byte, created uint32) []byte {
+	body := make([]byte, 0, publicKeyBodyLen)
+	body = append(body, 4) // version
+	body = binary.BigEndian.AppendUint32(body, created)
+	body = append(body, PubKeyAlgoEdDSA, byte(len(ed25519OID)))
+	body = append(body, ed25519OID...)
+	// MPI of the 0x40-prefixed native point: 263 bits
+	body = binary.BigEndian.AppendUint16(body, 263)
+	body = append(body, 0x40)
+	return append(body, pubKey...)
+}
+
+// Fingerprinter computes fingerprints of one public key for varying creation timestamps
+// without rebuilding the packet each time.
+type Fingerprinter struct {
+	buf [3 + publicKeyBodyLen]byte
+}
+
+// NewFingerprinter prepares the hashed packet for a public key.
+func NewFingerprinter(pubKey []byte) *Fingerprinter {
+	f := &Fingerprinter{}
+	f.buf[0] = 0x99
+	binary.BigEndian.PutUint16(f.buf[1:3], publicKeyBodyLen)
+	copy(f.buf[3:], PublicKeyBody(pubKey, 0))
+	return f
+}
+
+// Sum returns the fingerprint for the given creation timestamp.
+func (f *Fingerprinter) Sum(created uint32) [FingerprintSize]byte {
+	binary.BigEndian.PutUint32(f.buf[4:8], created)
+	return sha1.Sum(f.buf[:])
+}
+
+// Fingerprint returns the v4 fingerprint of an Ed25519 key created at the given time.
+func Fingerprint(pubKey []byte, created uint32) [FingerprintSize]byte {
+	return NewFingerprinter(pubKey).Sum(created)
+}
+
+// FormatFingerprint returns the fingerprint as uppercase hex, as shown by gpg.
+func FormatFingerprint(fp []byte) string {
+	return strings.ToUpper(hex.EncodeToString(fp))
+}
+
+// KeyID returns the long key ID (last 8 bytes) of a fingerprint.
+func KeyID(fp []byte) []byte {
+	return fp[len(fp)-KeyIDSize:]
+}
@@ -0,0 +1,155 @@
+package openpgp
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// batch is the number of creation timestamps a worker step tries.
+const batch = 4096
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.OpenPGP,
+		Name:         "OpenPGP",
+		Title:        "OpenPGP key (Ed25519)",
+		Icon:         "🔏",
+		Description:  "Hex fingerprint or key ID",
+		ResultLabel:  "OPENPGP KEY FINGERPRINT",
+		KeyType:      generator.KeyEd25519,
+		AddressTypes: []generator.AddressType{generator.AddressTypeFingerprint, generator.AddressTypeKeyID},
+		Rules: func(*generator.Config) generator.PatternRules {
+			// Fingerprints are often pasted in gpg's grouped form
+			rules := generator.HexRules("")
+			rules.Normalize = func(s string) string {
+				return strings.TrimPrefix(strings.ReplaceAll(s, " ", ""), "0x")
+			}
+			return rules
+		},
+		NewWorker: newWorker,
+		Options: []generator.Option{
+			{
+				Name:        OptionUserID,
+				Description: "user ID of the self-signed key (e.g. Alice <alice@example.com>); required",
+			},
+			{
+				Name:        OptionTimeWindow,
+				Description: "how far back creation timestamps are ground, as a duration (e.g. 720h; default 1 year)",
+				Validate: func(value string) error {
+					_, err := ParseTimeWindow(value)
+					return err
+				},
+			},
+		},
+	})
+}
+
+// Config.Options keys of the user ID and the creation time window.
+const (
+	OptionUserID     = "user-id"
+	OptionTimeWindow = "time-window"
+)
+
+// ParseTimeWindow parses a time window option value, a positive duration.
+func ParseTimeWindow(value string) (time.Duration, error) {
+	window, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if window < time.Second || window > time.Duration(math.MaxUint32)*time.Second {
+		return 0, fmt.Errorf("%s is not between 1s and %d seconds", window, uint32(math.MaxUint32))
+	}
+	return window, nil
+}
+
+// newWorker returns a CPU search step for OpenPGP v4 Ed25519 keys. Each key pair is ground
+// over a window of creation timestamps (SHA-1 fingerprint over the public key packet),
+// one batch per step.
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	userID := config.Option(OptionUserID)
+	if userID == "" {
+		return nil, fmt.Errorf("OpenPGP key requires the %s option", OptionUserID)
+	}
+	maxWindow := uint32(DefaultTimeWindow)
+	if value := config.Option(OptionTimeWindow); value != "" {
+		window, err := ParseTimeWindow(value)
+		if err != nil {
+			return nil, err
+		}
+		maxWindow = uint32(window / time.Second)
+	}
+
+	// Patterns match the hex of the whole fingerprint, or of the key ID (its last 8 bytes)
+	keyID := config.AddressType == generator.AddressTypeKeyID
+	var buf [40]byte
+	check := func(pattern *generator.Pattern, fp []byte) (int, bool) {
+		if keyID {
+			fp = KeyID(fp)
+		}
+		n := hex.Encode(buf[:], fp)
+		return pattern.CheckBytes(buf[:n])
+	}
+
+	// Current key pair and how far back its timestamps have been tried
+	var (
+		privKey     ed25519.PrivateKey
+		f           *Fingerprinter
+		now, window uint32
+		i           uint32
+	)
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		if f == nil {
+			pubKey, priv, err := ed25519.GenerateKey(rand.Reader)
+			if err != nil {
+				return 0, nil
+			}
+			// Walk back from now so keys are never created in the future
+			privKey, f = priv, NewFingerprinter(pubKey)
+			now = uint32(time.Now().Unix())
+			window = min(maxWindow, now)
+			i = 0
+		}
+
+		start := i
+		end := min(window, i+batch)
+		for ; i < end; i++ {
+			created := now - i
+			fp := f.Sum(created)
+			partial, ok := check(pattern, fp[:])
+			if !ok {
+				continue
+			}
+
+			// Best candidates continue with the next timestamp
+			i++
+			secretKey := SecretKey(privKey, created, userID, now)
+			return uint64(i - start), &generator.Result{
+				Network:    generator.OpenPGP,
+				Partial:    partial,
+				Address:    FormatFingerprint(fp[:]),
+				PrivateKey: hex.EncodeToString(privKey.Seed()),
+				Exports: []generator.KeyExport{
+					{Label: "Long Key ID", Value: FormatFingerprint(KeyID(fp[:]))},
+					{Label: "Created", Value: time.Unix(int64(created), 0).UTC().Format(time.RFC3339)},
+					{Label: "User ID", Value: userID},
+				},
+				Files: []generator.KeyFile{
+					{Name: SecretKeyFile, Data: Armor(PrivateKeyBlock, secretKey)},
+					{Name: PublicKeyFile, Data: Armor(PublicKeyBlock, PublicKey(privKey, created, userID, now))},
+				},
+			}
+		}
+		if i >= window {
+			f = nil
+		}
+		return uint64(end - start), nil
+	}, nil
+}
@@ -0,0 +1,142 @@
+package openpgp
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// OpenPGP packet tags.
+const (
+	tagSignature = 2
+	tagSecretKey = 5
+	tagPublicKey = 6
+	tagUserID    = 13
+)
+
+// Signature constants for the self-certification.
+const (
+	sigTypePositiveCert byte = 0x13
+	hashAlgoSHA256      byte = 8
+)
+
+// Signature subpacket types.
+const (
+	subCreationTime       = 2
+	subPreferredSymmetric = 11
+	subIssuer             = 16
+	subPreferredHash      = 21
+	subKeyFlags           = 27
+	subFeatures           = 30
+	subIssuerFingerprint  = 33
+)
+
+// packet wraps a body in a new-format packet header.
+func packet(tag byte, body []byte) []byte {
+	out := []byte{0xc0 | tag}
+	switch n := len(body); {
+	case n < 192:
+		out = append(out, byte(n))
+	case n < 8384:
+		n -= 192
+		out = append(out, byte(n>>8)+192, byte(n))
+	default:
+		out = append(out, 0xff)
+		out = binary.BigEndian.AppendUint32(out, uint32(n))
+	}
+	return append(out, body...)
+}
+
+// mpi encodes a big-endian integer as an OpenPGP MPI (bit count, then the bytes
+// without leading zeros).
+func mpi(b []byte) []byte {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	bitLen := 0
+	if len(b) > 0 {
+		bitLen = (len(b)-1)*8 + bits.Len8(b[0])
+	}
+	out := binary.BigEndian.AppendUint16(nil, uint16(bitLen))
+	return append(out, b...)
+}
+
+// subpacket encodes a signature subpacket.
+func subpacket(typ byte, data ...byte) []byte {
+	return append([]byte{byte(1 + len(data)), typ}, data...)
+}
+
+// secretKeyBody returns an unencrypted v4 secret key packet body.
+func secretKeyBody(seed, pubKey []byte, created uint32) []byte {
+	secret := mpi(seed)
+	var checksum uint16
+	for _, b := range secret {
+		checksum += uint16(b)
+	}
+
+	body := PublicKeyBody(pubKey, created)
+	body = append(body, 0) // S2K usage: not encrypted
+	body = append(body, secret...)
+	return binary.BigEndian.AppendUint16(body, checksum)
+}
+
+// certificationBody returns a positive self-certification of userID, signed at sigTime.
+func certificationBody(privKey ed25519.PrivateKey, created uint32, userID string, sigTime uint32) []byte {
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	fp := Fingerprint(pubKey, created)
+
+	var hashed []byte
+	hashed = append(hashed, subpacket(subCreationTime, binary.BigEndian.AppendUint32(nil, sigTime)...)...)
+	hashed = append(hashed, subpacket(subIssuerFingerprint, append([]byte{4}, fp[:]...)...)...)
+	hashed = append(hashed, subpacket(subKeyFlags, 0x03)...)              // certify + sign
+	hashed = append(hashed, subpacket(subPreferredSymmetric, 9, 8, 7)...) // AES-256, AES-192, AES-128
+	hashed = append(hashed, subpacket(subPreferredHash, 10, 9, 8)...)     // SHA-512, SHA-384, SHA-256
+	hashed = append(hashed, subpacket(subFeatures, 0x01)...)              // MDC
+	unhashed := subpacket(subIssuer, KeyID(fp[:])...)
+
+	sig := []byte{4, sigTypePositiveCert, PubKeyAlgoEdDSA, hashAlgoSHA256}
+	sig = binary.BigEndian.AppendUint16(sig, uint16(len(hashed)))
+	sig = append(sig, hashed...)
+
+	// Hash the key, the user ID and the signature's hashed part, then the v4 trailer
+	pubBody := PublicKeyBody(pubKey, created)
+	h := sha256.New()
+	h.Write([]byte{0x99})
+	h.Write(binary.BigEndian.AppendUint16(nil, uint16(len(pubBody))))
+	h.Write(pubBody)
+	h.Write([]byte{0xb4})
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(userID))))
+	h.Write([]byte(userID))
+	h.Write(sig)
+	h.Write([]byte{4, 0xff})
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(sig))))
+	digest := h.Sum(nil)
+
+	// EdDSA signs the digest itself; R and S are stored as two MPIs
+	signature := ed25519.Sign(privKey, digest)
+
+	sig = binary.BigEndian.AppendUint16(sig, uint16(len(unhashed)))
+	sig = append(sig, unhashed...)
+	sig = append(sig, digest[:2]...)
+	sig = append(sig, mpi(signature[:32])...)
+	return append(sig, mpi(signature[32:])...)
+}
+
+// SecretKey returns a transferable secret key (secret key, user ID, self-signature)
+// in binary OpenPGP format.
+func SecretKey(privKey ed25519.PrivateKey, created uint32, userID string, sigTime uint32) []byte {
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	out := packet(tagSecretKey, secretKeyBody(privKey.Seed(), pubKey, created))
+	out = append(out, packet(tagUserID, []byte(userID))...)
+	return append(out, packet(tagSignature, certificationBody(privKey, created, userID, sigTime))...)
+}
+
+// PublicKey returns a transferable public key (public key, user ID, self-signature)
+// in binary OpenPGP format.
+func PublicKey(privKey ed25519.PrivateKey, created uint32, userID string, sigTime uint32) []byte {
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	out := packet(tagPublicKey, PublicKeyBody(pubKey, created))
+	out = append(out, packet(tagUserID, []byte(userID))...)
+	return append(out, packet(tagSignature, certificationBody(privKey, created, userID, sigTime))...)
+}
@@ -0,0 +1,51 @@
+// Package openssh provides OpenSSH Ed25519 key vanity generation support.
+// Patterns are matched against either the SHA256 fingerprint ("SHA256:" + unpadded
+// Base64 of SHA-256(key blob)) or the Base64 key blob of the "ssh-ed25519 AAAA..." line.
+package openssh
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+)
+
+const (
+	// KeyType is the OpenSSH key type name for Ed25519 keys.
+	KeyType = "ssh-ed25519"
+	// FingerprintPrefix is the fixed leading part of every SHA256 fingerprint.
+	FingerprintPrefix = "SHA256:"
+	// PublicKeyPrefix is the fixed leading part of every ssh-ed25519 key blob in Base64:
+	// the encoded key type and key length, plus the first character that carries
+	// only their last 2 bits.
+	PublicKeyPrefix = "AAAAC3NzaC1lZDI1NTE5AAAAI"
+)
+
+// PublicKeyBlob returns the SSH wire encoding of an Ed25519 public key:
+// string "ssh-ed25519" followed by string pubkey, each with a 4-byte length.
+func PublicKeyBlob(pubKey []byte) []byte {
+	blob := make([]byte, 0, 4+len(KeyType)+4+len(pubKey))
+	blob = binary.BigEndian.AppendUint32(blob, uint32(len(KeyType)))
+	blob = append(blob, KeyType...)
+	blob = binary.BigEndian.AppendUint32(blob, uint32(len(pubKey)))
+	return append(blob, pubKey...)
+}
+
+// PublicKeyBase64 returns the Base64 key blob as it appears in authorized_keys.
+func PublicKeyBase64(pubKey []byte) string {
+	return base64.StdEncoding.EncodeToString(PublicKeyBlob(pubKey))
+}
+
+// Fingerprint returns the key's SHA256 fingerprint as shown by `ssh-keygen -l`.
+func Fingerprint(pubKey []byte) string {
+	sum := sha256.Sum256(PublicKeyBlob(pubKey))
+	return FingerprintPrefix + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// AuthorizedKey returns the single-line public key ("ssh-ed25519 AAAA... comment").
+func AuthorizedKey(pubKey []byte, comment string) string {
+	line := KeyType + " " + PublicKeyBase64(pubKey)
+	if comment != "" {
+		line += " " + comment
+	}
+	return line
+}
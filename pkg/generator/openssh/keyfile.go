@@ -0,0 +1,29 @@
+package openssh
+
+import (
+	"crypto/ed25519"
+	"encoding/pem"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Key file names written next to the result, as produced by `ssh-keygen -t ed25519`.
+const (
+	PrivateKeyFile = "id_ed25519"
+	PublicKeyFile  = "id_ed25519.pub"
+)
+
+// PrivateKeyFileData returns an unencrypted OpenSSH private key ("BEGIN OPENSSH PRIVATE KEY").
+func PrivateKeyFileData(privKey ed25519.PrivateKey, comment string) ([]byte, error) {
+	block, err := ssh.MarshalPrivateKey(privKey, comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OpenSSH private key: %w", err)
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+// PublicKeyFileData returns the contents of the .pub file.
+func PublicKeyFileData(pubKey []byte, comment string) []byte {
+	return []byte(AuthorizedKey(pubKey, comment) + "\n")
+}
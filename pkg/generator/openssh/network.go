@@ -0,0 +1,99 @@
+package openssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.SSH,
+		Name:         "SSH",
+		Title:        "OpenSSH key (Ed25519)",
+		Icon:         "🔐",
+		Description:  "SHA256 fingerprint or ssh-ed25519 text",
+		ResultLabel:  "OPENSSH ED25519 KEY",
+		KeyType:      generator.KeyEd25519,
+		AddressTypes: []generator.AddressType{generator.AddressTypeFingerprint, generator.AddressTypePublicKey},
+		Rules: func(config *generator.Config) generator.PatternRules {
+			// Fingerprints end with a 4-bit character; key text starts after the fixed key type blob
+			if config.AddressType == generator.AddressTypePublicKey {
+				return generator.PatternRules{
+					Alphabet:    generator.Base64,
+					Fixed:       KeyType + " " + PublicKeyPrefix,
+					PrefixChars: []string{PublicKeyNextCharRange},
+				}
+			}
+			return generator.PatternRules{
+				Alphabet:    generator.Base64,
+				Fixed:       FingerprintPrefix,
+				SuffixChars: []string{FingerprintLastCharRange},
+			}
+		},
+		NewWorker: newWorker,
+		Options: []generator.Option{{
+			Name:        OptionComment,
+			Description: "comment stored in the key files (e.g. deploy@ci; default none)",
+		}},
+	})
+}
+
+// OptionComment is the Config.Options key of the comment stored in the key files.
+const OptionComment = "comment"
+
+// newWorker returns a CPU search step for OpenSSH Ed25519 keys (SHA-256 fingerprint or Base64 key blob)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine matched text (default to fingerprint)
+	fingerprint := config.AddressType != generator.AddressTypePublicKey
+	comment := config.Option(OptionComment)
+	// Patterns match after "SHA256:" or the fixed key type blob
+	skip := len(FingerprintPrefix)
+	if !fingerprint {
+		skip = len(PublicKeyPrefix)
+	}
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		var text string
+		if fingerprint {
+			text = Fingerprint(pubKey)
+		} else {
+			text = PublicKeyBase64(pubKey)
+		}
+
+		partial, ok := pattern.Check(text[skip:])
+		if !ok {
+			return 1, nil
+		}
+		privFile, err := PrivateKeyFileData(privKey, comment)
+		if err != nil {
+			return 1, nil
+		}
+
+		// Show the matched text as the address, the other form as an export
+		address := Fingerprint(pubKey)
+		export := generator.KeyExport{Label: "Public Key", Value: AuthorizedKey(pubKey, comment)}
+		if !fingerprint {
+			address = AuthorizedKey(pubKey, comment)
+			export = generator.KeyExport{Label: "Fingerprint", Value: Fingerprint(pubKey)}
+		}
+		return 1, &generator.Result{
+			Network:    generator.SSH,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+			Exports:    []generator.KeyExport{export},
+			Files: []generator.KeyFile{
+				{Name: PrivateKeyFile, Data: privFile},
+				{Name: PublicKeyFile, Data: PublicKeyFileData(pubKey, comment)},
+			},
+		}
+	}, nil
+}
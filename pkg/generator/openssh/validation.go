@@ -0,0 +1,9 @@
+package openssh
+
+// PublicKeyNextCharRange lists the characters possible right after PublicKeyPrefix.
+// That character carries 2 zero bits of the key length and the top 4 bits of the key.
+const PublicKeyNextCharRange = "ABCDEFGHIJKLMNOP"
+
+// FingerprintLastCharRange lists the characters possible at the end of a fingerprint.
+// 32 bytes = 256 bits, so the 43rd character carries only 4 bits (plus 2 zero padding bits).
+const FingerprintLastCharRange = "AEIMQUYcgkosw048"
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Pattern is a prefix/suffix/contains search compiled for one network configuration.
+// It is cleaned and validated once, then shared by the CPU workers and the GPU backends,
+// so the three parts mean the same on every network and engine:
+//   - Prefix matches right after the fixed leading characters of the address
+//   - Suffix matches at the end of the address
+//   - Contains matches anywhere between the two
+//
+// Create it with CompilePattern or NewPattern.
+type Pattern struct {
+	Prefix   string       // Cleaned prefix, matched after Rules.Fixed
+	Suffix   string       // Cleaned suffix
+	Contains string       // Cleaned contains
+	Rules    PatternRules // Rules the pattern was validated against
+
+	// Byte copies for allocation-free matching in the hot loop
+	prefix, suffix, contains []byte
+
+	best atomic.Int32 // Highest score reported by Check
+}
+
+// CompilePattern cleans and validates the configured patterns with the network's rules.
+func CompilePattern(config *Config) (*Pattern, error) {
+	info, ok := Lookup(config.Network)
+	if !ok {
+		return nil, fmt.Errorf("network %d is not registered", int(config.Network))
+	}
+	if err := info.ValidateAddressType(config); err != nil {
+		return nil, err
+	}
+	if err := info.ValidateOptions(config); err != nil {
+		return nil, err
+	}
+	return NewPattern(info.Rules(config), config.Prefix, config.Suffix, config.Contains)
+}
+
+// NewPattern cleans and validates prefix, suffix and contains against rules.
+func NewPattern(rules PatternRules, prefix, suffix, contains string) (*Pattern, error) {
+	p := &Pattern{
+		Prefix:   rules.Clean(prefix),
+		Suffix:   rules.Clean(suffix),
+		Contains: rules.Clean(contains),
+		Rules:    rules,
+	}
+	if err := rules.ValidatePrefix(p.Prefix); err != nil {
+		return nil, fmt.Errorf("invalid prefix: %w", err)
+	}
+	if err := rules.ValidateSuffix(p.Suffix); err != nil {
+		return nil, fmt.Errorf("invalid suffix: %w", err)
+	}
+	if err := rules.ValidateContains(p.Contains); err != nil {
+		return nil, fmt.Errorf("invalid contains: %w", err)
+	}
+
+	p.prefix = []byte(p.Prefix)
+	p.suffix = []byte(p.Suffix)
+	p.contains = []byte(p.Contains)
+	return p, nil
+}
+
+// Replace compiles new prefix, suffix and contains patterns with the same rules,
+// to swap the pattern of a running search. Empty patterns are rejected, since they
+// would match the next candidate.
+func (p *Pattern) Replace(prefix, suffix, contains string) (*Pattern, error) {
+	next, err := NewPattern(p.Rules, prefix, suffix, contains)
+	if err != nil {
+		return nil, err
+	}
+	if next.Empty() {
+		return nil, fmt.Errorf("must specify prefix, suffix, or contains")
+	}
+	return next, nil
+}
+
+// Empty reports whether the pattern matches every address.
+func (p *Pattern) Empty() bool {
+	return p.Prefix == "" && p.Suffix == "" && p.Contains == ""
+}
+
+// Difficulty estimates the expected number of attempts to find a match.
+func (p *Pattern) Difficulty() uint64 {
+	return p.Rules.Difficulty(p.Prefix, p.Suffix, p.Contains)
+}
+
+// Match reports whether text matches the pattern.
+// Text is the address without its fixed leading characters (e.g. a Tron address after the 'T'),
+// in the case of the alphabet.
+func (p *Pattern) Match(text string) bool {
+	if !strings.HasPrefix(text, p.Prefix) || !strings.HasSuffix(text, p.Suffix) {
+		return false
+	}
+	if p.Contains == "" {
+		return true
+	}
+
+	start, end := p.middle(len(text))
+	return end-start >= len(p.Contains) && strings.Contains(text[start:end], p.Contains)
+}
+
+// MatchBytes is Match for text in a byte buffer. It never allocates.
+func (p *Pattern) MatchBytes(text []byte) bool {
+	if !bytes.HasPrefix(text, p.prefix) || !bytes.HasSuffix(text, p.suffix) {
+		return false
+	}
+	if len(p.contains) == 0 {
+		return true
+	}
+
+	start, end := p.middle(len(text))
+	return end-start >= len(p.contains) && bytes.Contains(text[start:end], p.contains)
+}
+
+// Check is Match that also reports near misses, for best-candidate events.
+// It returns ok for a match (partial is 0) and for a candidate that matches more
+// prefix and suffix characters than any checked before it by any worker (partial is
+// that number). Other candidates are not ok.
+func (p *Pattern) Check(text string) (partial int, ok bool) {
+	if p.Match(text) {
+		return 0, true
+	}
+	return p.improve(score(p.Prefix, p.Suffix, text))
+}
+
+// CheckBytes is Check for text in a byte buffer. It never allocates.
+func (p *Pattern) CheckBytes(text []byte) (partial int, ok bool) {
+	if p.MatchBytes(text) {
+		return 0, true
+	}
+	return p.improve(score(p.Prefix, p.Suffix, text))
+}
+
+// improve records score if it beats the best one so far.
+func (p *Pattern) improve(score int) (int, bool) {
+	for {
+		best := p.best.Load()
+		if int32(score) <= best {
+			return 0, false
+		}
+		if p.best.CompareAndSwap(best, int32(score)) {
+			return score, true
+		}
+	}
+}
+
+// score counts the characters of text that match prefix from the start and suffix from the end.
+func score[T string | []byte](prefix, suffix string, text T) int {
+	n := 0
+	for n < len(prefix) && n < len(text) && text[n] == prefix[n] {
+		n++
+	}
+	m := 0
+	for m < len(suffix) && m < len(text) && text[len(text)-1-m] == suffix[len(suffix)-1-m] {
+		m++
+	}
+	return n + m
+}
+
+// middle returns the section of a text of length n that contains is searched in:
+// between prefix and suffix, and before Rules.ContainsEnd if set.
+func (p *Pattern) middle(n int) (start, end int) {
+	start, end = len(p.Prefix), n-len(p.Suffix)
+	if p.Rules.ContainsEnd > 0 && end > p.Rules.ContainsEnd {
+		end = p.Rules.ContainsEnd
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// GPUPattern is a Pattern in the form the OpenCL kernels take it.
+type GPUPattern struct {
+	Prefix, Suffix, Contains []byte // Pattern text, one byte per character
+
+	// Hex patterns packed two characters per byte, for kernels that compare raw hash bytes.
+	// Odd-length prefixes and contains are padded with a zero nibble at the end, suffixes at the start.
+	PrefixHex, SuffixHex, ContainsHex []byte
+	PrefixOdd, SuffixOdd, ContainsOdd bool // The part has an odd number of hex characters
+}
+
+// GPU returns the pattern encoded for upload to a GPU kernel.
+// The packed hex fields are only set for Hex patterns.
+func (p *Pattern) GPU() GPUPattern {
+	gpu := GPUPattern{
+		Prefix:   []byte(p.Prefix),
+		Suffix:   []byte(p.Suffix),
+		Contains: []byte(p.Contains),
+	}
+	if p.Rules.Alphabet.Name != Hex.Name {
+		return gpu
+	}
+
+	gpu.PrefixHex, gpu.PrefixOdd = packHex(p.Prefix, false)
+	gpu.SuffixHex, gpu.SuffixOdd = packHex(p.Suffix, true)
+	gpu.ContainsHex, gpu.ContainsOdd = packHex(p.Contains, false)
+	return gpu
+}
+
+// packHex packs hex text two characters per byte, padding odd lengths with a zero nibble
+// at the start (padStart) or at the end.
+func packHex(s string, padStart bool) ([]byte, bool) {
+	odd := len(s)%2 == 1
+	if odd {
+		if padStart {
+			s = "0" + s
+		} else {
+			s += "0"
+		}
+	}
+	packed, _ := hex.DecodeString(s) // Validated against the Hex alphabet
+	return packed, odd
+}
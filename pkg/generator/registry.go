@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// CustomNetwork is the first Network ID for networks registered outside this module.
+// Built-in networks stay below it, so new built-ins never collide with custom ones.
+const CustomNetwork Network = 1000
+
+// KeyType is the key algorithm a network derives its addresses from.
+type KeyType int
+
+const (
+	KeySecp256k1 KeyType = iota // secp256k1 (ECDSA / BIP-340 Schnorr)
+	KeyEd25519                  // Ed25519
+	KeyX25519                   // X25519 (Curve25519 Diffie-Hellman)
+)
+
+// String returns the key type name.
+func (k KeyType) String() string {
+	switch k {
+	case KeySecp256k1:
+		return "secp256k1"
+	case KeyEd25519:
+		return "Ed25519"
+	case KeyX25519:
+		return "X25519"
+	default:
+		return "Unknown"
+	}
+}
+
+// Worker runs one step of a CPU search: it generates one candidate key (or one batch,
+// for networks that grind many addresses per key), matches it against pattern and returns
+// how many candidates it checked and the matching result, if any.
+// The pattern is passed on every step, so it can be replaced while the search runs.
+// A Worker is only called from the goroutine it was created for, so it may keep state between calls.
+type Worker func(pattern *Pattern) (attempts uint64, result *Result)
+
+// NetworkInfo describes a network: what its addresses look like and how they are searched.
+// Each network package registers its own in an init function.
+type NetworkInfo struct {
+	Network     Network // Unique network ID (CustomNetwork and up for third-party networks)
+	Name        string  // Short name, returned by Network.String (e.g. "Ethereum")
+	Title       string  // Menu title (e.g. "Ethereum (ETH)")
+	Icon        string  // Menu and result icon (e.g. "⟠")
+	Description string  // Menu description (e.g. "0x prefix, Hex")
+	ResultLabel string  // Heading shown above a found address (e.g. "ETHEREUM ADDRESS")
+	KeyType     KeyType // Key algorithm the addresses are derived from
+
+	// AddressTypes lists the address types the network accepts in Config.AddressType,
+	// its default first. Nil if it has none; AddressTypeDefault is always accepted.
+	AddressTypes []AddressType
+
+	// Rules returns the pattern rules for a configuration
+	// (alphabet, fixed leading characters, restricted positions).
+	Rules func(config *Config) PatternRules
+
+	// NewWorker validates the configuration and returns a Worker for one CPU search goroutine.
+	// It is called once per goroutine. Nil if the network has no CPU search.
+	NewWorker func(config *Config) (Worker, error)
+
+	// GPU creates a GPU backend for the network. Nil if the network is CPU-only.
+	GPU func() (Generator, error)
+
+	// Options lists the settings the network accepts in Config.Options (e.g. a network ID).
+	Options []Option
+}
+
+// Option is a network-specific setting, passed by name in Config.Options.
+// Unset options take the network's default.
+type Option struct {
+	Name        string                   // Key in Config.Options (e.g. "ss58")
+	Description string                   // What the value sets, including its default
+	Validate    func(value string) error // Rejects invalid values; nil accepts any
+}
+
+// ValidateAddressType checks that the network supports config.AddressType.
+func (info NetworkInfo) ValidateAddressType(config *Config) error {
+	if config.AddressType != AddressTypeDefault && !slices.Contains(info.AddressTypes, config.AddressType) {
+		return fmt.Errorf("%s has no address type %s", info.Name, config.AddressType)
+	}
+	return nil
+}
+
+// ValidateOptions checks config.Options against the options of the network:
+// every name must be one of them and every value valid.
+func (info NetworkInfo) ValidateOptions(config *Config) error {
+	for name, value := range config.Options {
+		i := slices.IndexFunc(info.Options, func(o Option) bool { return o.Name == name })
+		if i < 0 {
+			return fmt.Errorf("%s has no option %q", info.Name, name)
+		}
+		if validate := info.Options[i].Validate; validate != nil {
+			if err := validate(value); err != nil {
+				return fmt.Errorf("invalid %s option %s: %w", info.Name, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[Network]NetworkInfo)
+)
+
+// Register makes a network available to the engines, the UI and difficulty estimation.
+// It panics if the network ID is already registered or the descriptor is incomplete.
+func Register(info NetworkInfo) {
+	if info.Name == "" {
+		panic(fmt.Sprintf("generator: network %d registered without a name", int(info.Network)))
+	}
+	if info.Rules == nil {
+		panic("generator: network " + info.Name + " registered without pattern rules")
+	}
+	if info.NewWorker == nil && info.GPU == nil {
+		panic("generator: network " + info.Name + " registered without a CPU or GPU search")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if existing, dup := registry[info.Network]; dup {
+		panic(fmt.Sprintf("generator: network %d registered twice (%s, %s)", int(info.Network), existing.Name, info.Name))
+	}
+	registry[info.Network] = info
+}
+
+// Lookup returns the descriptor of a registered network.
+func Lookup(n Network) (NetworkInfo, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	info, ok := registry[n]
+	return info, ok
+}
+
+// Networks returns all registered networks, ordered by ID.
+func Networks() []NetworkInfo {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	infos := make([]NetworkInfo, 0, len(registry))
+	for _, info := range registry {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Network < infos[j].Network })
+	return infos
+}
+
+// EstimateDifficulty returns the expected number of attempts to match the configured patterns.
+func EstimateDifficulty(config *Config) uint64 {
+	pattern, err := CompilePattern(config)
+	if err != nil {
+		return 1
+	}
+	return pattern.Difficulty()
+}
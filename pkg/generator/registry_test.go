@@ -0,0 +1,28 @@
+package generator
+
+import "testing"
+
+func TestNetworkInfoValidateAddressType(t *testing.T) {
+	withTypes := NetworkInfo{Name: "Bitcoin", AddressTypes: []AddressType{AddressTypeTaproot, AddressTypeLegacy}}
+	withoutTypes := NetworkInfo{Name: "Ethereum"}
+	tests := []struct {
+		name        string
+		info        NetworkInfo
+		addressType AddressType
+		wantErr     bool
+	}{
+		{"default", withTypes, AddressTypeDefault, false},
+		{"supported", withTypes, AddressTypeLegacy, false},
+		{"other network's type", withTypes, AddressTypeAge, true},
+		{"default without types", withoutTypes, AddressTypeDefault, false},
+		{"type without types", withoutTypes, AddressTypeTaproot, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.info.ValidateAddressType(&Config{AddressType: tt.addressType})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAddressType(%s) = %v, want error %v", tt.addressType, err, tt.wantErr)
+			}
+		})
+	}
+}
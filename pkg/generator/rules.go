@@ -0,0 +1,245 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// Alphabet is the character set of an address encoding.
+type Alphabet struct {
+	Name          string // Encoding name for messages (e.g. "Base58")
+	Chars         string // Characters a pattern may use
+	CaseSensitive bool   // If false, patterns are folded to the case of Chars
+	Hint          string // Shown next to invalid characters (e.g. "Not allowed: 0, O, I, l")
+}
+
+// Address encodings used by the built-in networks.
+var (
+	Hex         = Alphabet{Name: "Hex", Chars: "0123456789abcdef", Hint: "Allowed: 0-9, a-f"}
+	Base58      = Alphabet{Name: "Base58", Chars: "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz", CaseSensitive: true, Hint: "Not allowed: 0, O, I, l"}
+	Bech32      = Alphabet{Name: "Bech32", Chars: "qpzry9x8gf2tvdw0s3jn54khce6mua7l", Hint: "Not allowed: 1, b, i, o"}
+	Base32      = Alphabet{Name: "Base32", Chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567", Hint: "Allowed: A-Z, 2-7"}
+	Base32Lower = Alphabet{Name: "Base32", Chars: "abcdefghijklmnopqrstuvwxyz234567", Hint: "Allowed: a-z, 2-7"}
+	Base64      = Alphabet{Name: "Base64", Chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/", CaseSensitive: true, Hint: "Allowed: A-Z, a-z, 0-9, +, /"}
+	Base64URL   = Alphabet{Name: "Base64url", Chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_", CaseSensitive: true, Hint: "Allowed: A-Z, a-z, 0-9, -, _"}
+)
+
+// HexRules returns the pattern rules for plain hex text after the fixed characters.
+// A typed "0x" is dropped, so patterns can be pasted from an address.
+func HexRules(fixed string) PatternRules {
+	return PatternRules{
+		Alphabet: Hex,
+		Fixed:    fixed,
+		Normalize: func(s string) string {
+			return strings.TrimPrefix(s, "0x")
+		},
+	}
+}
+
+// IsValidPrefixRange reports whether a Base58 address starting with fixed + prefix can lie
+// between the smallest and largest possible addresses min and max (which include fixed).
+// The addresses must all have the same length; the Base58 alphabet is in ASCII order,
+// so comparing strings then compares the encoded numbers.
+func IsValidPrefixRange(fixed, min, max, prefix string) bool {
+	full := fixed + prefix
+	if len(full) > len(min) {
+		return false
+	}
+	pad := len(min) - len(full)
+	lowest := full + strings.Repeat(Base58.Chars[:1], pad)
+	highest := full + strings.Repeat(Base58.Chars[len(Base58.Chars)-1:], pad)
+	return highest >= min && lowest <= max
+}
+
+// PrefixRangeChars returns the Base58 characters possible right after fixed
+// for addresses between min and max (see IsValidPrefixRange).
+func PrefixRangeChars(fixed, min, max string) string {
+	var chars []byte
+	for _, c := range []byte(Base58.Chars) {
+		if IsValidPrefixRange(fixed, min, max, string(c)) {
+			chars = append(chars, c)
+		}
+	}
+	return string(chars)
+}
+
+// Fold converts s to the case of the alphabet, unless the alphabet is case-sensitive.
+func (a Alphabet) Fold(s string) string {
+	switch {
+	case a.CaseSensitive:
+		return s
+	case a.Chars == strings.ToLower(a.Chars):
+		return strings.ToLower(s)
+	default:
+		return strings.ToUpper(s)
+	}
+}
+
+// InvalidChars returns the characters of s missing from the alphabet.
+func (a Alphabet) InvalidChars(s string) []rune {
+	var invalid []rune
+	for _, c := range s {
+		if !strings.ContainsRune(a.Chars, c) {
+			invalid = append(invalid, c)
+		}
+	}
+	return invalid
+}
+
+// PatternError is a rejected pattern, with an optional hint on what is allowed.
+type PatternError struct {
+	Msg  string
+	Hint string
+}
+
+func (e *PatternError) Error() string {
+	if e.Hint == "" {
+		return e.Msg
+	}
+	return e.Msg + " (" + e.Hint + ")"
+}
+
+// PatternRules describes which prefix, suffix and contains patterns a network's addresses can match.
+// Patterns are matched after Fixed, so the prefix never includes it.
+type PatternRules struct {
+	Alphabet    Alphabet // Encoding of the matched text
+	Fixed       string   // Leading characters shared by every address, shown before the prefix
+	PrefixChars []string // Characters possible at the first positions after Fixed, where fewer than the alphabet
+	SuffixChars []string // Characters possible at the last positions, counting back from the last one
+	ContainsEnd int      // If set, contains must end within this many characters after Fixed (e.g. before a fixed part)
+
+	Normalize   func(s string) string     // Optional clean-up of typed text (e.g. dropping "0x" or dashes)
+	CheckPrefix func(prefix string) error // Optional check beyond PrefixChars (e.g. Base58 range limits)
+	CheckSuffix func(suffix string) error // Optional check beyond SuffixChars
+}
+
+// Clean trims, normalizes and case-folds typed pattern text.
+func (r PatternRules) Clean(s string) string {
+	s = r.Alphabet.Fold(strings.TrimSpace(s))
+	if r.Normalize != nil {
+		s = r.Normalize(s)
+	}
+	return s
+}
+
+// ValidatePrefix checks that addresses can start with Fixed followed by prefix.
+func (r PatternRules) ValidatePrefix(prefix string) error {
+	if err := r.validateChars(prefix); err != nil {
+		return err
+	}
+	for i, c := range prefix {
+		if i < len(r.PrefixChars) && !strings.ContainsRune(r.PrefixChars[i], c) {
+			position := "first character"
+			if i > 0 {
+				position = fmt.Sprintf("character %d", i+1)
+			}
+			if r.Fixed != "" {
+				position += fmt.Sprintf(" after '%s'", r.Fixed)
+			}
+			return &PatternError{
+				Msg:  fmt.Sprintf("%s must be one of: %s", position, r.PrefixChars[i]),
+				Hint: "Other characters are not possible at this position",
+			}
+		}
+	}
+	if prefix != "" && r.CheckPrefix != nil {
+		return r.CheckPrefix(prefix)
+	}
+	return nil
+}
+
+// ValidateSuffix checks that addresses can end with suffix.
+func (r PatternRules) ValidateSuffix(suffix string) error {
+	if err := r.validateChars(suffix); err != nil {
+		return err
+	}
+	for i := 0; i < len(suffix) && i < len(r.SuffixChars); i++ {
+		c := rune(suffix[len(suffix)-1-i])
+		if !strings.ContainsRune(r.SuffixChars[i], c) {
+			position := "last character"
+			if i > 0 {
+				position = fmt.Sprintf("character %d from the end", i+1)
+			}
+			return &PatternError{
+				Msg:  fmt.Sprintf("%s must be one of: %s", position, r.SuffixChars[i]),
+				Hint: "Other characters are not possible at this position",
+			}
+		}
+	}
+	if suffix != "" && r.CheckSuffix != nil {
+		return r.CheckSuffix(suffix)
+	}
+	return nil
+}
+
+// ValidateContains checks that contains only uses characters of the alphabet.
+func (r PatternRules) ValidateContains(contains string) error {
+	return r.validateChars(contains)
+}
+
+// validateChars rejects characters outside the alphabet.
+func (r PatternRules) validateChars(s string) error {
+	if invalid := r.Alphabet.InvalidChars(s); len(invalid) > 0 {
+		return &PatternError{
+			Msg:  fmt.Sprintf("invalid %s character(s): %s", r.Alphabet.Name, string(invalid)),
+			Hint: r.Alphabet.Hint,
+		}
+	}
+	return nil
+}
+
+// Difficulty estimates the expected number of attempts to match the patterns.
+// Restricted positions count with their own number of possible characters.
+// Difficulties beyond the range of uint64 are capped at math.MaxUint64.
+func (r PatternRules) Difficulty(prefix, suffix, contains string) uint64 {
+	if prefix == "" && suffix == "" && contains == "" {
+		return 1
+	}
+
+	base := uint64(len(r.Alphabet.Chars))
+	difficulty := uint64(1)
+
+	for i := range prefix {
+		if i < len(r.PrefixChars) {
+			difficulty = mulCapped(difficulty, uint64(len(r.PrefixChars[i])))
+		} else {
+			difficulty = mulCapped(difficulty, base)
+		}
+	}
+	for i := range suffix {
+		if i < len(r.SuffixChars) {
+			difficulty = mulCapped(difficulty, uint64(len(r.SuffixChars[i])))
+		} else {
+			difficulty = mulCapped(difficulty, base)
+		}
+	}
+
+	// Add contains difficulty (approximate)
+	// Contains can appear anywhere in ~20 positions, so divide by that
+	if len(contains) > 0 {
+		containsDiff := uint64(1)
+		for range contains {
+			containsDiff = mulCapped(containsDiff, base)
+		}
+		if containsDiff != math.MaxUint64 {
+			containsDiff /= 20
+		}
+		if containsDiff < 1 {
+			containsDiff = 1
+		}
+		difficulty = mulCapped(difficulty, containsDiff)
+	}
+
+	return difficulty
+}
+
+// mulCapped returns a*b, or math.MaxUint64 if the product overflows.
+func mulCapped(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
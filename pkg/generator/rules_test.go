@@ -0,0 +1,33 @@
+package generator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPatternRulesDifficulty(t *testing.T) {
+	hex := PatternRules{Alphabet: Hex}
+	base58 := PatternRules{Alphabet: Base58}
+	tests := []struct {
+		name                     string
+		rules                    PatternRules
+		prefix, suffix, contains string
+		want                     uint64
+	}{
+		{name: "no pattern", rules: hex, want: 1},
+		{name: "prefix and suffix", rules: hex, prefix: "ab", suffix: "c", want: 16 * 16 * 16},
+		{name: "restricted positions", rules: PatternRules{Alphabet: Hex, PrefixChars: []string{"01"}}, prefix: "0a", want: 2 * 16},
+		{name: "exact", rules: hex, prefix: "012345678", suffix: "abcdef", want: 1 << 60},
+		// 16^16 and 58^11 do not fit in a uint64
+		{name: "hex overflow", rules: hex, prefix: "0123456789abcdef", want: math.MaxUint64},
+		{name: "base58 overflow", rules: base58, prefix: "abcdefghijk", want: math.MaxUint64},
+		{name: "contains overflow", rules: base58, prefix: "a", contains: "abcdefghijk", want: math.MaxUint64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.Difficulty(tt.prefix, tt.suffix, tt.contains); got != tt.want {
+				t.Errorf("Difficulty(%q, %q, %q) = %d, want %d", tt.prefix, tt.suffix, tt.contains, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,10 +3,11 @@ package solana
 import (
 	"strings"
 
+	"github.com/Amr-9/HexHunter/pkg/generator"
 	"github.com/mr-tron/base58"
 )
 
-// Base58 character constants (using alphabet from matcher_solana.go)
+// Base58 character constants (using alphabet from validation.go)
 const (
 	base58MinChar = '1' // Smallest Base58 character (value 0)
 	base58MaxChar = 'z' // Largest Base58 character (value 57)
@@ -41,7 +42,7 @@ func CalculateBase58Range(prefix string) (*Base58Range, error) {
 
 	// Validate prefix contains only valid Base58 characters
 	for _, c := range prefix {
-		if !strings.ContainsRune(base58Alphabet, c) {
+		if !strings.ContainsRune(generator.Base58.Chars, c) {
 			return nil, &InvalidBase58Error{Char: c}
 		}
 	}
@@ -93,7 +94,7 @@ func CalculateBase58SuffixRange(suffix string) (*Base58Range, error) {
 
 	// Validate suffix
 	for _, c := range suffix {
-		if !strings.ContainsRune(base58Alphabet, c) {
+		if !strings.ContainsRune(generator.Base58.Chars, c) {
 			return nil, &InvalidBase58Error{Char: c}
 		}
 	}
@@ -163,5 +164,3 @@ type InvalidBase58Error struct {
 func (e *InvalidBase58Error) Error() string {
 	return "invalid Base58 character: " + string(e.Char)
 }
-
-
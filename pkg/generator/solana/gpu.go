@@ -26,9 +26,6 @@ import (
 	"crypto/rand"
 	_ "embed"
 	"fmt"
-	"log"
-	"sync/atomic"
-	"time"
 	"unsafe"
 
 	"github.com/Amr-9/HexHunter/pkg/generator"
@@ -67,15 +64,11 @@ type SolanaGPUGenerator struct {
 	bufContains      C.cl_mem // Runtime contains bytes (max 44 bytes)
 
 	// stats
-	attempts  uint64
-	startTime time.Time
+	lifecycle generator.Lifecycle
 
 	// matching config
-	prefix        string
-	suffix        string
-	contains      string
+	pattern       *generator.Pattern
 	caseSensitive bool
-	matcher       *SolanaMatcher
 }
 
 // NewSolanaGPUGenerator creates a new Solana GPU-based generator.
@@ -83,8 +76,7 @@ func NewSolanaGPUGenerator() (*SolanaGPUGenerator, error) {
 	g := &SolanaGPUGenerator{
 		caseSensitive: true, // default to case sensitive
 	}
-	// Note: We don't initialize OpenCL here because we need prefix/suffix first
-	// OpenCL will be initialized in Start()
+	// OpenCL is initialized by the first Start, so creating the generator is cheap
 	return g, nil
 }
 
@@ -93,42 +85,60 @@ func (g *SolanaGPUGenerator) Name() string {
 }
 
 func (g *SolanaGPUGenerator) Stats() generator.Stats {
-	attempts := atomic.LoadUint64(&g.attempts)
-	elapsed := time.Since(g.startTime).Seconds()
-	var hashRate float64
-	if elapsed > 0 {
-		hashRate = float64(attempts) / elapsed
-	}
-	return generator.Stats{
-		Attempts:    attempts,
-		HashRate:    hashRate,
-		ElapsedSecs: elapsed,
+	return g.lifecycle.Stats()
+}
+
+// UpdatePattern replaces the pattern of the running search. The GPU loop rewrites
+// the pattern buffers before its next batch.
+func (g *SolanaGPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return g.lifecycle.UpdatePattern(prefix, suffix, contains)
+}
+
+// Wait blocks until the GPU loop of the last search has exited.
+func (g *SolanaGPUGenerator) Wait() {
+	g.lifecycle.Wait()
+}
+
+// Close stops the running search, waits for it and releases the OpenCL context, queue and kernel.
+func (g *SolanaGPUGenerator) Close() error {
+	if g.lifecycle.Close() {
+		g.release()
 	}
+	return nil
 }
 
-func (g *SolanaGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
-	resultChan := make(chan generator.Result, 1)
-	g.startTime = time.Now()
-	atomic.StoreUint64(&g.attempts, 0)
+func (g *SolanaGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
+	pattern, err := generator.CompilePattern(config)
+	if err != nil {
+		return nil, err
+	}
 
-	g.prefix = config.Prefix
-	g.suffix = config.Suffix
-	g.contains = config.Contains
-	g.matcher = NewSolanaMatcher(config.Prefix, config.Suffix, config.Contains)
+	// Begin waits for the previous search, which still uses the buffers
+	ctx, err = g.lifecycle.Begin(ctx, config, pattern)
+	if err != nil {
+		return nil, err
+	}
 
-	// Initialize OpenCL with the specific prefix/suffix
-	if err := g.initOpenCL(); err != nil {
-		return nil, fmt.Errorf("failed to initialize OpenCL: %w", err)
+	// OpenCL is set up by the first search and kept until Close
+	if g.kernel == nil {
+		if err := g.initOpenCL(); err != nil {
+			g.release()
+			g.lifecycle.End()
+			return nil, fmt.Errorf("failed to initialize OpenCL: %w", err)
+		}
 	}
 
-	go g.runGPU(ctx, resultChan)
-	return resultChan, nil
+	stream := generator.NewStream(ctx, config, g.Stats)
+	go func() {
+		err := g.runGPU(ctx, stream)
+		stream.Finish(g.lifecycle.End(), err)
+	}()
+	return stream.Events(), nil
 }
 
-func (g *SolanaGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.Result) {
+func (g *SolanaGPUGenerator) runGPU(ctx context.Context, stream *generator.Stream) error {
 	if err := g.createBuffers(); err != nil {
-		log.Printf("Buffer creation failed: %v", err)
-		return
+		return fmt.Errorf("buffer creation failed: %w", err)
 	}
 	defer g.releaseBuffers()
 
@@ -146,8 +156,15 @@ func (g *SolanaGPUGenerator) runGPU(ctx context.Context, resultChan chan<- gener
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		default:
+			// Apply a pattern replaced by UpdatePattern before the next batch
+			if pattern := g.lifecycle.Pattern(); pattern != g.pattern {
+				if err := g.writePattern(pattern); err != nil {
+					return fmt.Errorf("pattern update failed: %w", err)
+				}
+			}
+
 			// 1. Generate random base seed
 			rand.Read(baseSeed)
 
@@ -155,32 +172,28 @@ func (g *SolanaGPUGenerator) runGPU(ctx context.Context, resultChan chan<- gener
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufSeed, C.CL_TRUE, 0, 32,
 				unsafe.Pointer(&baseSeed[0]), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to write seed: %d", ret)
-				return
+				return fmt.Errorf("failed to write seed: %d", ret)
 			}
 
 			// 3. Upload occupied_bytes
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufOccupiedBytes, C.CL_TRUE, 0, 1,
 				unsafe.Pointer(&occupiedBytes), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to write occupied_bytes: %d", ret)
-				return
+				return fmt.Errorf("failed to write occupied_bytes: %d", ret)
 			}
 
 			// 4. Upload group_offset
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufGroupOffset, C.CL_TRUE, 0, 1,
 				unsafe.Pointer(&groupOffset), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to write group_offset: %d", ret)
-				return
+				return fmt.Errorf("failed to write group_offset: %d", ret)
 			}
 
 			// 5. Clear output buffer
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, 33,
 				unsafe.Pointer(&zeros[0]), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to clear output: %d", ret)
-				return
+				return fmt.Errorf("failed to clear output: %d", ret)
 			}
 
 			// 6. Run kernel
@@ -189,19 +202,20 @@ func (g *SolanaGPUGenerator) runGPU(ctx context.Context, resultChan chan<- gener
 			ret = C.clEnqueueNDRangeKernel(g.queue, g.kernel, 1, nil,
 				&globalSize, &localSize, 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Kernel failed: %d", ret)
-				return
+				return fmt.Errorf("kernel failed: %d", ret)
 			}
 
 			// 7. Read output
 			ret = C.clEnqueueReadBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, 33,
 				unsafe.Pointer(&hostOutput[0]), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Read output failed: %d", ret)
-				return
+				return fmt.Errorf("read output failed: %d", ret)
 			}
 
-			// 8. Check if found (output[0] = length, non-zero means match found)
+			// 8. Update stats, before a match so the final stats include this batch
+			g.lifecycle.Add(uint64(solanaBatchSize))
+
+			// 9. Check if found (output[0] = length, non-zero means match found)
 			if hostOutput[0] != 0 {
 				foundSeed := hostOutput[1:33]
 
@@ -210,22 +224,19 @@ func (g *SolanaGPUGenerator) runGPU(ctx context.Context, resultChan chan<- gener
 				pubKey := privKey.Public().(ed25519.PublicKey)
 				address := base58.Encode(pubKey)
 
-				// Double-check with matcher
-				if g.matcher.Matches(address) {
-					resultChan <- generator.Result{
+				// Double-check with the pattern
+				if g.pattern.Match(address) {
+					stream.Match(generator.Result{
 						Network:    generator.Solana,
 						Address:    address,
 						PrivateKey: base58.Encode(privKey),
-					}
-					return
+					})
+					return nil
 				}
 				// False positive from kernel, continue
-				log.Printf("GPU false positive: address=%s (expected prefix=%s)", address, g.prefix)
+				stream.Warn(fmt.Errorf("GPU false positive: address=%s (expected prefix=%s)", address, g.pattern.Prefix))
 			}
 
-			// 9. Update stats
-			atomic.AddUint64(&g.attempts, uint64(solanaBatchSize))
-
 			// 10. Increment group offset for next batch
 			groupOffset++
 			if groupOffset == 0 {
@@ -349,10 +360,36 @@ func (g *SolanaGPUGenerator) createBuffers() error {
 		return fmt.Errorf("bufContains failed: %d", ret)
 	}
 
-	// Upload prefix/suffix/contains data to GPU
-	prefixBytes := []byte(g.prefix)
-	suffixBytes := []byte(g.suffix)
-	containsBytes := []byte(g.contains)
+	// Set kernel arguments
+	// Kernel signature: generate_pubkey(seed, out, occupied_bytes, group_offset,
+	//                                   prefix, suffix, contains, prefix_len, suffix_len, contains_len, case_sensitive)
+	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufSeed)), unsafe.Pointer(&g.bufSeed))
+	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
+	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOccupiedBytes)), unsafe.Pointer(&g.bufOccupiedBytes))
+	C.clSetKernelArg(g.kernel, 3, C.size_t(unsafe.Sizeof(g.bufGroupOffset)), unsafe.Pointer(&g.bufGroupOffset))
+	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufPrefix)), unsafe.Pointer(&g.bufPrefix))
+	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufSuffix)), unsafe.Pointer(&g.bufSuffix))
+	C.clSetKernelArg(g.kernel, 6, C.size_t(unsafe.Sizeof(g.bufContains)), unsafe.Pointer(&g.bufContains))
+
+	// Pass case sensitivity as a value; the lengths are set with the pattern
+	caseSensitive := C.uint(1)
+	if !g.caseSensitive {
+		caseSensitive = C.uint(0)
+	}
+	C.clSetKernelArg(g.kernel, 10, C.size_t(unsafe.Sizeof(caseSensitive)), unsafe.Pointer(&caseSensitive))
+
+	return g.writePattern(g.lifecycle.Pattern())
+}
+
+// writePattern uploads the prefix/suffix/contains data and lengths to the GPU.
+// It runs when the buffers are created and between batches after UpdatePattern.
+func (g *SolanaGPUGenerator) writePattern(pattern *generator.Pattern) error {
+	var ret C.cl_int
+
+	gpu := pattern.GPU()
+	prefixBytes := gpu.Prefix
+	suffixBytes := gpu.Suffix
+	containsBytes := gpu.Contains
 
 	if len(prefixBytes) > 0 {
 		ret = C.clEnqueueWriteBuffer(g.queue, g.bufPrefix, C.CL_TRUE, 0,
@@ -378,31 +415,14 @@ func (g *SolanaGPUGenerator) createBuffers() error {
 		}
 	}
 
-	// Set kernel arguments
-	// Kernel signature: generate_pubkey(seed, out, occupied_bytes, group_offset,
-	//                                   prefix, suffix, contains, prefix_len, suffix_len, contains_len, case_sensitive)
-	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufSeed)), unsafe.Pointer(&g.bufSeed))
-	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
-	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOccupiedBytes)), unsafe.Pointer(&g.bufOccupiedBytes))
-	C.clSetKernelArg(g.kernel, 3, C.size_t(unsafe.Sizeof(g.bufGroupOffset)), unsafe.Pointer(&g.bufGroupOffset))
-	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufPrefix)), unsafe.Pointer(&g.bufPrefix))
-	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufSuffix)), unsafe.Pointer(&g.bufSuffix))
-	C.clSetKernelArg(g.kernel, 6, C.size_t(unsafe.Sizeof(g.bufContains)), unsafe.Pointer(&g.bufContains))
-
-	// Pass lengths and case sensitivity as values
-	prefixLen := C.uint(len(g.prefix))
-	suffixLen := C.uint(len(g.suffix))
-	containsLen := C.uint(len(g.contains))
-	caseSensitive := C.uint(1)
-	if !g.caseSensitive {
-		caseSensitive = C.uint(0)
-	}
-
+	prefixLen := C.uint(len(prefixBytes))
+	suffixLen := C.uint(len(suffixBytes))
+	containsLen := C.uint(len(containsBytes))
 	C.clSetKernelArg(g.kernel, 7, C.size_t(unsafe.Sizeof(prefixLen)), unsafe.Pointer(&prefixLen))
 	C.clSetKernelArg(g.kernel, 8, C.size_t(unsafe.Sizeof(suffixLen)), unsafe.Pointer(&suffixLen))
 	C.clSetKernelArg(g.kernel, 9, C.size_t(unsafe.Sizeof(containsLen)), unsafe.Pointer(&containsLen))
-	C.clSetKernelArg(g.kernel, 10, C.size_t(unsafe.Sizeof(caseSensitive)), unsafe.Pointer(&caseSensitive))
 
+	g.pattern = pattern
 	return nil
 }
 
@@ -430,17 +450,21 @@ func (g *SolanaGPUGenerator) releaseBuffers() {
 	}
 }
 
-func (g *SolanaGPUGenerator) Release() {
+func (g *SolanaGPUGenerator) release() {
 	if g.kernel != nil {
 		C.clReleaseKernel(g.kernel)
+		g.kernel = nil
 	}
 	if g.program != nil {
 		C.clReleaseProgram(g.program)
+		g.program = nil
 	}
 	if g.queue != nil {
 		C.clReleaseCommandQueue(g.queue)
+		g.queue = nil
 	}
 	if g.clCtx != nil {
 		C.clReleaseContext(g.clCtx)
+		g.clCtx = nil
 	}
 }
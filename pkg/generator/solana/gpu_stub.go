@@ -30,9 +30,19 @@ func (g *SolanaGPUGenerator) Stats() generator.Stats {
 }
 
 // Start returns an error as GPU is not available.
-func (g *SolanaGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
+func (g *SolanaGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
 	return nil, fmt.Errorf("Solana GPU support not compiled")
 }
 
-// Release does nothing.
-func (g *SolanaGPUGenerator) Release() {}
+// UpdatePattern returns an error as GPU is not available.
+func (g *SolanaGPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return fmt.Errorf("Solana GPU support not compiled")
+}
+
+// Wait does nothing.
+func (g *SolanaGPUGenerator) Wait() {}
+
+// Close does nothing.
+func (g *SolanaGPUGenerator) Close() error {
+	return nil
+}
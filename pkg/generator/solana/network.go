@@ -0,0 +1,57 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/mr-tron/base58"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Solana,
+		Name:        "Solana",
+		Title:       "Solana (SOL)",
+		Icon:        "◎",
+		Description: "Base58",
+		ResultLabel: "SOLANA ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.PatternRules{Alphabet: generator.Base58}
+		},
+		NewWorker: newWorker,
+		GPU: func() (generator.Generator, error) {
+			gen, err := NewSolanaGPUGenerator()
+			if err != nil {
+				return nil, err
+			}
+			return gen, nil
+		},
+	})
+}
+
+// newWorker returns a CPU search step for Solana addresses (Ed25519 + Base58)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Solana address is the Base58-encoded public key
+		address := base58.Encode(pubKey)
+
+		partial, ok := pattern.Check(address)
+		if !ok {
+			return 1, nil
+		}
+		// Solana uses 64-byte keypair (seed + pubkey)
+		return 1, &generator.Result{
+			Network:    generator.Solana,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: base58.Encode(privKey),
+		}
+	}, nil
+}
@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"math"
+	"time"
+)
+
+// Probability returns the chance that at least one of attempts random addresses matches
+// a pattern of the given difficulty: 1 - (1 - 1/difficulty)^attempts.
+// A difficulty of 0 is unknown and has no chance.
+func Probability(attempts, difficulty uint64) float64 {
+	if difficulty == 0 {
+		return 0
+	}
+	if difficulty == 1 {
+		return 1
+	}
+	// -expm1(n·log1p(-1/d)) keeps its precision for large difficulties, where 1 - 1/d rounds to 1
+	return -math.Expm1(float64(attempts) * math.Log1p(-1/float64(difficulty)))
+}
+
+// AttemptsFor returns how many attempts reach the given probability of a match
+// for a pattern of the given difficulty. It is the inverse of Probability.
+func AttemptsFor(probability float64, difficulty uint64) float64 {
+	if difficulty <= 1 || probability <= 0 {
+		return 0
+	}
+	if probability >= 1 {
+		return math.Inf(1)
+	}
+	return math.Log1p(-probability) / math.Log1p(-1/float64(difficulty))
+}
+
+// TimeTo returns how long the search is expected to take, from now and at its recent
+// hash rate (the average one until that is measured), until the probability of a match
+// reaches probability (e.g. 0.9). It is 0 once the probability is reached, and false when
+// it cannot be estimated yet (no hash rate or no difficulty). Durations beyond the range
+// of time.Duration are capped.
+func (s Stats) TimeTo(probability float64) (time.Duration, bool) {
+	if s.Difficulty != 0 && s.Probability >= probability {
+		return 0, true
+	}
+	rate := s.RecentHashRate
+	if rate <= 0 {
+		rate = s.HashRate
+	}
+	if rate <= 0 || s.Difficulty == 0 {
+		return 0, false
+	}
+	remaining := AttemptsFor(probability, s.Difficulty) - AttemptsFor(s.Probability, s.Difficulty)
+	seconds := remaining / rate
+	if seconds >= math.MaxInt64/float64(time.Second) {
+		return math.MaxInt64, true
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// rateHalfLife is how fast RateMeter forgets: a sample this old weighs half as much as a new one.
+const rateHalfLife = 3 * time.Second
+
+// rateMinInterval is the shortest time between two samples of a RateMeter,
+// so frequent Stats calls do not turn timing noise into rate noise.
+const rateMinInterval = 250 * time.Millisecond
+
+// RateMeter computes an exponentially weighted moving average (EWMA) of a hash rate,
+// which follows a GPU warming up or throttling within seconds, unlike the average
+// since start. Samples are weighted by time, so the result does not depend on how often
+// Sample is called. The zero value is ready to use; it is not safe for concurrent use.
+type RateMeter struct {
+	attempts uint64    // Attempts at the last sample
+	time     time.Time // Time of the last sample; zero before the first
+	rate     float64
+}
+
+// Sample records the total attempts of a search at a time and returns the smoothed rate.
+// The first sample only sets the starting point: until the next one, the rate is 0.
+func (m *RateMeter) Sample(attempts uint64, now time.Time) float64 {
+	if m.time.IsZero() || attempts < m.attempts {
+		m.attempts, m.time, m.rate = attempts, now, 0
+		return 0
+	}
+	elapsed := now.Sub(m.time)
+	if elapsed < rateMinInterval {
+		return m.rate
+	}
+
+	rate := float64(attempts-m.attempts) / elapsed.Seconds()
+	if m.rate == 0 {
+		m.rate = rate
+	} else {
+		weight := 1 - math.Exp2(-elapsed.Seconds()/rateHalfLife.Seconds())
+		m.rate += weight * (rate - m.rate)
+	}
+	m.attempts, m.time = attempts, now
+	return m.rate
+}
+
+// Reset forgets the samples, e.g. when a new search starts.
+func (m *RateMeter) Reset() {
+	*m = RateMeter{}
+}
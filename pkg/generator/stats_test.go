@@ -0,0 +1,154 @@
+package generator
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestProbability(t *testing.T) {
+	tests := []struct {
+		name       string
+		attempts   uint64
+		difficulty uint64
+		want       float64
+	}{
+		// (1 - 1/d)^d tends to 1/e
+		{"difficulty attempts", 1 << 40, 1 << 40, 1 - 1/math.E},
+		{"small difficulty", 16, 16, 1 - math.Pow(15.0/16, 16)},
+		{"one attempt", 1, 1 << 40, 1.0 / (1 << 40)},
+		{"no attempts", 0, 1 << 40, 0},
+		{"no pattern", 0, 1, 1},
+		// 0 is an unknown difficulty, not a certain match
+		{"unknown difficulty", 1000, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Probability(tt.attempts, tt.difficulty); math.Abs(got-tt.want) > 1e-9*math.Max(tt.want, 1e-12) {
+				t.Errorf("Probability(%d, %d) = %v, want %v", tt.attempts, tt.difficulty, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttemptsFor(t *testing.T) {
+	const difficulty = 1 << 32
+	for _, p := range []float64{1e-6, 0.5, 0.9, 0.99} {
+		attempts := AttemptsFor(p, difficulty)
+		if got := Probability(uint64(math.Round(attempts)), difficulty); math.Abs(got-p) > 1e-6 {
+			t.Errorf("Probability(AttemptsFor(%v)) = %v", p, got)
+		}
+	}
+	// Half the chance takes ln 2 times the difficulty
+	if got, want := AttemptsFor(0.5, difficulty), math.Ln2*difficulty; math.Abs(got-want) > 1 {
+		t.Errorf("AttemptsFor(0.5) = %v, want %v", got, want)
+	}
+	if got := AttemptsFor(0, difficulty); got != 0 {
+		t.Errorf("AttemptsFor(0) = %v, want 0", got)
+	}
+	if got := AttemptsFor(1, difficulty); !math.IsInf(got, 1) {
+		t.Errorf("AttemptsFor(1) = %v, want +Inf", got)
+	}
+}
+
+func TestStatsTimeTo(t *testing.T) {
+	const difficulty = 1 << 20
+	tests := []struct {
+		name   string
+		stats  Stats
+		p      float64
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "no rate",
+			stats:  Stats{Difficulty: difficulty},
+			p:      0.5,
+			wantOK: false,
+		},
+		{
+			name:   "no difficulty",
+			stats:  Stats{RecentHashRate: 1000},
+			p:      0.5,
+			wantOK: false,
+		},
+		{
+			name:   "reached",
+			stats:  Stats{Difficulty: difficulty, Probability: 0.6},
+			p:      0.5,
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "recent rate",
+			stats:  Stats{Difficulty: difficulty, HashRate: 1, RecentHashRate: AttemptsFor(0.5, difficulty)},
+			p:      0.5,
+			want:   time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "average rate before the recent one",
+			stats:  Stats{Difficulty: difficulty, HashRate: AttemptsFor(0.5, difficulty) / 2},
+			p:      0.5,
+			want:   2 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "capped",
+			stats:  Stats{Difficulty: math.MaxUint64, RecentHashRate: 1e-9},
+			p:      0.99,
+			want:   math.MaxInt64,
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.stats.TimeTo(tt.p)
+			if ok != tt.wantOK {
+				t.Fatalf("TimeTo(%v) ok = %v, want %v", tt.p, ok, tt.wantOK)
+			}
+			if diff := got - tt.want; diff < -time.Millisecond || diff > time.Millisecond {
+				t.Errorf("TimeTo(%v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateMeter(t *testing.T) {
+	var m RateMeter
+	start := time.Unix(0, 0)
+
+	if got := m.Sample(0, start); got != 0 {
+		t.Fatalf("first sample = %v, want 0", got)
+	}
+	// The first rate is taken as it is
+	if got := m.Sample(1000, start.Add(time.Second)); got != 1000 {
+		t.Fatalf("rate after 1s at 1000/s = %v, want 1000", got)
+	}
+	// Samples closer than rateMinInterval keep the previous rate
+	if got := m.Sample(1100, start.Add(time.Second+rateMinInterval/2)); got != 1000 {
+		t.Errorf("rate within the min interval = %v, want 1000", got)
+	}
+	// After one half-life at 3000/s, the old rate weighs half
+	now := start.Add(time.Second + rateHalfLife)
+	if got := m.Sample(1000+3000*uint64(rateHalfLife/time.Second), now); math.Abs(got-2000) > 1e-6 {
+		t.Errorf("rate after a half-life at 3000/s = %v, want 2000", got)
+	}
+
+	// The weight depends on the time, not on how often Sample is called
+	var often RateMeter
+	often.Sample(0, start)
+	often.Sample(1000, start.Add(time.Second))
+	var got float64
+	for i := 1; i <= 12; i++ {
+		got = often.Sample(1000+uint64(i)*750, start.Add(time.Second+time.Duration(i)*rateHalfLife/12))
+	}
+	if math.Abs(got-2000) > 1e-6 {
+		t.Errorf("rate after a half-life at 3000/s in 12 samples = %v, want 2000", got)
+	}
+
+	m.Reset()
+	if got := m.Sample(5000, now.Add(time.Second)); got != 0 {
+		t.Errorf("first sample after Reset = %v, want 0", got)
+	}
+}
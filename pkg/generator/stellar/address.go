@@ -0,0 +1,61 @@
+// Package stellar provides Stellar vanity account generation support.
+// Stellar accounts are Ed25519 public keys encoded as StrKey (G...),
+// with the secret seed exported as StrKey too (S...).
+package stellar
+
+import (
+	"encoding/base32"
+)
+
+const (
+	// VersionAccountID is the StrKey version byte for public keys (6 << 3, encodes as 'G').
+	VersionAccountID byte = 6 << 3
+	// VersionSeed is the StrKey version byte for secret seeds (18 << 3, encodes as 'S').
+	VersionSeed byte = 18 << 3
+	// AddressPrefix is the fixed first character of every account ID.
+	AddressPrefix = "G"
+	// AddressLen is the length of an encoded account ID.
+	AddressLen = 56
+)
+
+// strKeyEncoding is RFC 4648 Base32 without padding, as used by StrKey.
+var strKeyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// DeriveAddress encodes an Ed25519 public key as a Stellar account ID (G...).
+func DeriveAddress(pubKey []byte) string {
+	return EncodeStrKey(VersionAccountID, pubKey)
+}
+
+// SeedToStrKey encodes a 32-byte Ed25519 seed as a Stellar secret seed (S...).
+func SeedToStrKey(seed []byte) string {
+	return EncodeStrKey(VersionSeed, seed)
+}
+
+// EncodeStrKey encodes a payload as StrKey:
+// Base32(version || payload || CRC16-XModem(version || payload)), checksum little-endian.
+func EncodeStrKey(version byte, payload []byte) string {
+	data := make([]byte, 0, 1+len(payload)+2)
+	data = append(data, version)
+	data = append(data, payload...)
+
+	crc := crc16XModem(data)
+	data = append(data, byte(crc), byte(crc>>8))
+
+	return strKeyEncoding.EncodeToString(data)
+}
+
+// crc16XModem computes CRC-16/XMODEM (poly 0x1021, init 0x0000).
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
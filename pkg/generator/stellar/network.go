@@ -0,0 +1,54 @@
+package stellar
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Stellar,
+		Name:        "Stellar",
+		Title:       "Stellar (XLM)",
+		Icon:        "✦",
+		Description: "Base32, G prefix",
+		ResultLabel: "STELLAR ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The first character after 'G' has only 4 possible values (A-D)
+			return generator.PatternRules{
+				Alphabet:    generator.Base32,
+				Fixed:       "G",
+				PrefixChars: []string{SecondCharRange},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Stellar accounts (Ed25519 + StrKey Base32)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Stellar account ID = StrKey(0x30, pubkey)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		// Secret seed = StrKey(0x90, seed)
+		return 1, &generator.Result{
+			Network:    generator.Stellar,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: SeedToStrKey(privKey.Seed()),
+		}
+	}, nil
+}
@@ -0,0 +1,5 @@
+package stellar
+
+// SecondCharRange lists the characters possible right after the 'G'.
+// The version byte 0x30 leaves only the top 2 bits of the key for that character.
+const SecondCharRange = "ABCD"
@@ -0,0 +1,73 @@
+// Package substrate provides Polkadot/Substrate SS58 vanity address generation support.
+// SS58 = Base58(network prefix || pubkey || checksum), where checksum is the first
+// 2 bytes of Blake2b-512("SS58PRE" || network prefix || pubkey).
+package substrate
+
+import (
+	"encoding/hex"
+
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Well-known SS58 network IDs.
+const (
+	PolkadotID uint16 = 0  // Polkadot relay chain (addresses start with 1)
+	KusamaID   uint16 = 2  // Kusama relay chain (addresses start with C-H)
+	GenericID  uint16 = 42 // Generic Substrate (addresses start with 5)
+
+	// MaxNetworkID is the largest ID SS58 can encode (14 bits).
+	MaxNetworkID uint16 = 16383
+)
+
+const (
+	pubKeyLen   = 32
+	checksumLen = 2
+)
+
+// ss58Pre is the checksum preimage prefix defined by the SS58 spec.
+var ss58Pre = []byte("SS58PRE")
+
+// IsValidNetworkID reports whether id can be used as an SS58 address prefix.
+// IDs 46 and 47 are reserved by the spec.
+func IsValidNetworkID(id uint16) bool {
+	return id <= MaxNetworkID && id != 46 && id != 47
+}
+
+// EncodeNetworkPrefix encodes an SS58 network ID as 1 byte (< 64) or 2 bytes.
+func EncodeNetworkPrefix(id uint16) []byte {
+	if id < 64 {
+		return []byte{byte(id)}
+	}
+	first := byte((id&0x00FC)>>2) | 0x40
+	second := byte(id>>8) | byte((id&0x0003)<<6)
+	return []byte{first, second}
+}
+
+// DeriveAddress encodes a 32-byte public key as an SS58 address for the given network ID.
+func DeriveAddress(pubKey []byte, networkID uint16) string {
+	prefix := EncodeNetworkPrefix(networkID)
+
+	data := make([]byte, 0, len(prefix)+pubKeyLen+checksumLen)
+	data = append(data, prefix...)
+	data = append(data, pubKey...)
+
+	h, _ := blake2b.New512(nil)
+	h.Write(ss58Pre)
+	h.Write(data)
+	checksum := h.Sum(nil)
+
+	data = append(data, checksum[:checksumLen]...)
+	return base58.Encode(data)
+}
+
+// SeedToHex returns the 32-byte Ed25519 seed as 0x-prefixed hex,
+// the "raw seed" form accepted by subkey and polkadot.js.
+func SeedToHex(seed []byte) string {
+	return "0x" + hex.EncodeToString(seed)
+}
+
+// PublicKeyToHex returns the public key as 0x-prefixed hex.
+func PublicKeyToHex(pubKey []byte) string {
+	return "0x" + hex.EncodeToString(pubKey)
+}
@@ -0,0 +1,102 @@
+package substrate
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"strconv"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Substrate,
+		Name:        "Substrate",
+		Title:       "Polkadot/Substrate (DOT)",
+		Icon:        "●",
+		Description: "SS58, Polkadot/Kusama/generic",
+		ResultLabel: "SUBSTRATE ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(config *generator.Config) generator.PatternRules {
+			// The network ID limits which characters can follow the fixed ones
+			networkID, _ := NetworkID(config) // Validated with the options
+			fixed := FixedPrefix(networkID)
+			return generator.PatternRules{
+				Alphabet:    generator.Base58,
+				Fixed:       fixed,
+				PrefixChars: []string{string(NextChars(networkID, fixed))},
+				CheckPrefix: func(prefix string) error {
+					if !IsValidPrefix(networkID, prefix) {
+						return fmt.Errorf("no address for network %d starts with '%s%s'", networkID, fixed, prefix)
+					}
+					return nil
+				},
+			}
+		},
+		NewWorker: newWorker,
+		Options: []generator.Option{{
+			Name:        OptionNetworkID,
+			Description: "SS58 network ID (0 = Polkadot, 2 = Kusama, 42 = generic; default 0)",
+			Validate: func(value string) error {
+				_, err := ParseNetworkID(value)
+				return err
+			},
+		}},
+	})
+}
+
+// OptionNetworkID is the Config.Options key of the SS58 network ID (default PolkadotID).
+const OptionNetworkID = "ss58"
+
+// ParseNetworkID parses an SS58 network ID option value.
+func ParseNetworkID(value string) (uint16, error) {
+	id, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || !IsValidNetworkID(uint16(id)) {
+		return 0, fmt.Errorf("%q is not an SS58 network ID (0-%d, except 46 and 47)", value, MaxNetworkID)
+	}
+	return uint16(id), nil
+}
+
+// NetworkID returns the SS58 network ID of the configuration.
+func NetworkID(config *generator.Config) (uint16, error) {
+	if value := config.Option(OptionNetworkID); value != "" {
+		return ParseNetworkID(value)
+	}
+	return PolkadotID, nil
+}
+
+// newWorker returns a CPU search step for Polkadot/Substrate addresses (Ed25519 + SS58)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	networkID, err := NetworkID(config)
+	if err != nil {
+		return nil, err
+	}
+	// Patterns match after the characters fixed by the network prefix
+	skip := len(FixedPrefix(networkID))
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// SS58 = Base58(network prefix || pubkey || Blake2b-512("SS58PRE" || ...)[:2])
+		address := DeriveAddress(pubKey, networkID)
+
+		partial, ok := pattern.Check(address[skip:])
+		if !ok {
+			return 1, nil
+		}
+		// subkey / polkadot.js import the raw 0x seed with the ed25519 scheme
+		return 1, &generator.Result{
+			Network:    generator.Substrate,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: SeedToHex(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Public Key (hex)", Value: PublicKeyToHex(pubKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,158 @@
+package substrate
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+var big58 = big.NewInt(58)
+
+// FixedPrefix returns the leading characters shared by every address for a network ID
+// (e.g. "1" for Polkadot, "5" for generic Substrate, "" for Kusama).
+// User prefixes are matched after these characters.
+func FixedPrefix(networkID uint16) string {
+	fixed := ""
+	for {
+		next := NextChars(networkID, fixed)
+		if len(next) != 1 {
+			return fixed
+		}
+		fixed += string(next[0])
+	}
+}
+
+// NextChars returns the characters that can follow the given address prefix.
+func NextChars(networkID uint16, prefix string) []rune {
+	var chars []rune
+	for _, c := range generator.Base58.Chars {
+		if IsPossiblePrefix(networkID, prefix+string(c)) {
+			chars = append(chars, c)
+		}
+	}
+	return chars
+}
+
+// IsPossiblePrefix reports whether any address for the network ID starts with prefix.
+// The address is Base58 of prefix bytes || 34 free bytes (pubkey + checksum), so the
+// encoded value lies in a known numeric range; prefix is possible if the range of values
+// whose Base58 form starts with it intersects that range for some address length.
+func IsPossiblePrefix(networkID uint16, prefix string) bool {
+	for _, c := range prefix {
+		if !strings.ContainsRune(generator.Base58.Chars, c) {
+			return false
+		}
+	}
+
+	zeros, lo, hi := valueRange(networkID)
+
+	// Leading zero bytes of the network prefix always encode as '1'
+	for i := 0; i < zeros; i++ {
+		if i >= len(prefix) {
+			return true
+		}
+		if prefix[i] != '1' {
+			return false
+		}
+	}
+	rest := prefix[zeros:]
+	if rest == "" {
+		return true
+	}
+	if rest[0] == '1' {
+		// A further '1' means a zero leading byte in the free part,
+		// which only a zero-valued network prefix allows
+		return lo.Sign() == 0
+	}
+
+	// t = numeric value of rest
+	t := new(big.Int)
+	for _, c := range rest {
+		t.Mul(t, big58)
+		t.Add(t, big.NewInt(int64(strings.IndexRune(generator.Base58.Chars, c))))
+	}
+
+	maxLen := len(base58Encode(hi))
+	for length := len(rest); length <= maxLen; length++ {
+		scale := new(big.Int).Exp(big58, big.NewInt(int64(length-len(rest))), nil)
+
+		// Values whose Base58 form of this length starts with rest
+		startLo := new(big.Int).Mul(t, scale)
+		startHi := new(big.Int).Add(t, big.NewInt(1))
+		startHi.Mul(startHi, scale)
+		startHi.Sub(startHi, big.NewInt(1))
+
+		// Values that encode to exactly this many digits
+		digitsLo := new(big.Int).Exp(big58, big.NewInt(int64(length-1)), nil)
+		digitsHi := new(big.Int).Exp(big58, big.NewInt(int64(length)), nil)
+		digitsHi.Sub(digitsHi, big.NewInt(1))
+
+		low := maxBig(startLo, digitsLo, lo)
+		high := minBig(startHi, digitsHi, hi)
+		if low.Cmp(high) <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueRange returns the number of leading zero bytes of the network prefix and the
+// numeric range of the remaining bytes (network prefix remainder || 34 free bytes).
+func valueRange(networkID uint16) (int, *big.Int, *big.Int) {
+	prefix := EncodeNetworkPrefix(networkID)
+
+	zeros := 0
+	for zeros < len(prefix) && prefix[zeros] == 0 {
+		zeros++
+	}
+
+	free := pubKeyLen + checksumLen
+	loBytes := append([]byte{}, prefix[zeros:]...)
+	hiBytes := append([]byte{}, prefix[zeros:]...)
+	for i := 0; i < free; i++ {
+		loBytes = append(loBytes, 0x00)
+		hiBytes = append(hiBytes, 0xFF)
+	}
+
+	return zeros, new(big.Int).SetBytes(loBytes), new(big.Int).SetBytes(hiBytes)
+}
+
+// base58Encode encodes a non-negative integer in Base58 (no leading-zero handling).
+func base58Encode(n *big.Int) string {
+	if n.Sign() == 0 {
+		return ""
+	}
+	var out []byte
+	v := new(big.Int).Set(n)
+	mod := new(big.Int)
+	for v.Sign() > 0 {
+		v.DivMod(v, big58, mod)
+		out = append(out, generator.Base58.Chars[mod.Int64()])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+func maxBig(vals ...*big.Int) *big.Int {
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v.Cmp(m) > 0 {
+			m = v
+		}
+	}
+	return m
+}
+
+func minBig(vals ...*big.Int) *big.Int {
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v.Cmp(m) < 0 {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,7 @@
+package substrate
+
+// IsValidPrefix reports whether a user prefix (matched after FixedPrefix)
+// can occur in any address for the network ID.
+func IsValidPrefix(networkID uint16, prefix string) bool {
+	return IsPossiblePrefix(networkID, FixedPrefix(networkID)+prefix)
+}
@@ -0,0 +1,20 @@
+package sui
+
+import (
+	"encoding/hex"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// DeriveAddress computes the Sui address from an Ed25519 public key.
+// Sui address = Blake2b-256(0x00 || pubkey) where 0x00 is Ed25519 signature scheme flag.
+func DeriveAddress(pubKey []byte) string {
+	// Prepend 0x00 (Ed25519 signature scheme flag)
+	data := make([]byte, len(pubKey)+1)
+	data[0] = 0x00
+	copy(data[1:], pubKey)
+
+	// Compute Blake2b-256
+	hash := blake2b.Sum256(data)
+	return "0x" + hex.EncodeToString(hash[:])
+}
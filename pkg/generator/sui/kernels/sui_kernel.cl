@@ -1,5 +1,5 @@
 // Sui-specific kernel code
-// This file is concatenated with ed25519_core.cl by kernel_builder.go
+// Defines derive_address for the shared Ed25519 hex kernel (see ed25519hex/kernel_builder.go)
 // Implements Blake2b-256 for Sui address derivation (RFC 7693 compliant)
 
 /* ========== Blake2b-256 Implementation ========== */
@@ -120,140 +120,13 @@ void blake2b_256(const uchar *input, int input_len, uchar *output) {
     }
 }
 
-/* ========== Hex encoding ========== */
-__constant uchar sui_hex_chars[] = "0123456789abcdef";
-
-inline void sui_bytes_to_hex(const uchar *bytes, int len, uchar *hex_out) {
-    for (int i = 0; i < len; i++) {
-        hex_out[i * 2] = sui_hex_chars[bytes[i] >> 4];
-        hex_out[i * 2 + 1] = sui_hex_chars[bytes[i] & 0x0F];
-    }
-}
-
-/* ========== Sui Address Generation Kernel ========== */
-__kernel void generate_sui_address(
-    constant uchar *seed,
-    global uchar *out,
-    global uchar *occupied_bytes,
-    global uchar *group_offset,
-    constant uchar *prefix,
-    constant uchar *suffix,
-    constant uchar *contains,
-    const uint prefix_len,
-    const uint suffix_len,
-    const uint contains_len,
-    const uint case_sensitive
-) {
-    uchar public_key[32] __attribute__((aligned(4)));
-    uchar private_key[64];
-    uchar key_base[32];
-    uchar hash_input[33];
-    uchar address[32];
-    uchar address_hex[64];
-
-    #pragma unroll
-    for (size_t i = 0; i < 32; i++) {
-        key_base[i] = seed[i];
-    }
-    const int global_id = (*group_offset) * get_global_size(0) + get_global_id(0);
-
-    for (size_t i = 0; i < *occupied_bytes; i++) {
-        key_base[31 - i] += ((global_id >> (i * 8)) & 0xFF);
-    }
-
-    // Generate Ed25519 keypair
-    ed25519_create_keypair(public_key, private_key, key_base);
-
-    // Prepare hash input: 0x00 || pubkey (Ed25519 scheme flag FIRST for Sui)
-    hash_input[0] = 0x00;
-    for (int i = 0; i < 32; i++) {
-        hash_input[i + 1] = public_key[i];
-    }
-
-    // Compute Blake2b-256
-    blake2b_256(hash_input, 33, address);
-
-    // Convert to hex
-    sui_bytes_to_hex(address, 32, address_hex);
-
-    // Pattern matching
-    unsigned int match = 1;
-
-    // Check prefix
-    for (uint i = 0; i < prefix_len && match; i++) {
-        uchar addr_char = address_hex[i];
-        uchar prefix_char = prefix[i];
-        
-        if (!case_sensitive) {
-            addr_char = to_lower_char(addr_char);
-            prefix_char = to_lower_char(prefix_char);
-        }
-        
-        if (addr_char != prefix_char) {
-            match = 0;
-        }
-    }
-
-    // Check suffix
-    for (uint i = 0; i < suffix_len && match; i++) {
-        uchar addr_char = address_hex[64 - suffix_len + i];
-        uchar suffix_char = suffix[i];
-        
-        if (!case_sensitive) {
-            addr_char = to_lower_char(addr_char);
-            suffix_char = to_lower_char(suffix_char);
-        }
-        
-        if (addr_char != suffix_char) {
-            match = 0;
-        }
-    }
-
-    // Check contains - search for pattern in middle section
-    if (match && contains_len > 0) {
-        uint start_pos = prefix_len;
-        uint end_pos = 64 - suffix_len;
-        
-        if (end_pos <= start_pos || contains_len > end_pos - start_pos) {
-            match = 0;
-        } else {
-            unsigned int contains_found = 0;
-            
-            // Sliding window search through middle section
-            for (uint pos = start_pos; pos <= end_pos - contains_len && !contains_found; pos++) {
-                unsigned int pos_match = 1;
-                
-                for (uint i = 0; i < contains_len && pos_match; i++) {
-                    uchar addr_char = address_hex[pos + i];
-                    uchar contains_char = contains[i];
-                    
-                    if (!case_sensitive) {
-                        addr_char = to_lower_char(addr_char);
-                        contains_char = to_lower_char(contains_char);
-                    }
-                    
-                    if (addr_char != contains_char) {
-                        pos_match = 0;
-                    }
-                }
-                
-                if (pos_match) {
-                    contains_found = 1;
-                }
-            }
-            
-            if (!contains_found) {
-                match = 0;
-            }
-        }
-    }
-
-    if (match) {
-        if (out[0] == 0) {
-            out[0] = 64;
-            for (size_t j = 0; j < 32; j++) {
-                out[j + 1] = key_base[j];
-            }
-        }
-    }
+/* ========== Sui address ========== */
+/* Address = Blake2b-256(0x00 || pubkey), the Ed25519 scheme flag coming first */
+void derive_address(const uchar *public_key, uchar *address) {
+  uchar hash_input[33];
+  hash_input[0] = 0x00;
+  for (int i = 0; i < 32; i++) {
+    hash_input[i + 1] = public_key[i];
+  }
+  blake2b_256(hash_input, 33, address);
 }
@@ -0,0 +1,52 @@
+package sui
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Sui,
+		Name:        "Sui",
+		Title:       "Sui (SUI)",
+		Icon:        "◇",
+		Description: "0x prefix, Hex",
+		ResultLabel: "SUI ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.HexRules("0x")
+		},
+		NewWorker: newWorker,
+		GPU:       newGPUGenerator,
+	})
+}
+
+// newWorker returns a CPU search step for Sui addresses (Ed25519 + Blake2b-256)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Sui address = Blake2b-256(0x00 || pubkey)
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(strings.TrimPrefix(address, "0x"))
+		if !ok {
+			return 1, nil
+		}
+		// Return the seed (first 32 bytes of privKey) as hex
+		return 1, &generator.Result{
+			Network:    generator.Sui,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+		}
+	}, nil
+}
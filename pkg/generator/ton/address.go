@@ -0,0 +1,71 @@
+// Package ton provides TON wallet vanity address generation support.
+// A wallet address is the hash of its contract StateInit (wallet code + data holding the
+// Ed25519 public key), shown as Base64url(flags || workchain || hash || CRC16).
+package ton
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// User-friendly address flags.
+const (
+	FlagBounceable    byte = 0x11 // EQ... / Ef...
+	FlagNonBounceable byte = 0x51 // UQ... / Uf...
+)
+
+// Workchains.
+const (
+	BaseWorkchain   int8 = 0
+	MasterWorkchain int8 = -1
+)
+
+// AddressLen is the length of a user-friendly address (36 bytes in Base64).
+const AddressLen = 48
+
+// FixedLen is the number of leading characters set by the flags and workchain bytes alone.
+const FixedLen = 2
+
+// EncodeAddress returns the user-friendly form of an account ID.
+func EncodeAddress(workchain int8, hash []byte, bounceable bool) string {
+	flag := FlagNonBounceable
+	if bounceable {
+		flag = FlagBounceable
+	}
+
+	var data [36]byte
+	data[0] = flag
+	data[1] = byte(workchain)
+	copy(data[2:34], hash)
+	binary.BigEndian.PutUint16(data[34:], crc16XModem(data[:34]))
+	return base64.URLEncoding.EncodeToString(data[:])
+}
+
+// RawAddress returns the raw "workchain:hex" form of an account ID.
+func RawAddress(workchain int8, hash []byte) string {
+	return fmt.Sprintf("%d:%s", workchain, hex.EncodeToString(hash))
+}
+
+// AddressPrefix returns the fixed leading characters for a workchain and flag
+// (e.g. "EQ" for bounceable basechain, "Uf" for non-bounceable masterchain).
+func AddressPrefix(workchain int8, bounceable bool) string {
+	return EncodeAddress(workchain, make([]byte, 32), bounceable)[:FixedLen]
+}
+
+// crc16XModem computes CRC-16/XMODEM (poly 0x1021, init 0).
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
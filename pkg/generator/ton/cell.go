@@ -0,0 +1,74 @@
+package ton
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// cellBuilder accumulates the data bits of an ordinary TON cell.
+type cellBuilder struct {
+	data   []byte
+	bitLen int
+}
+
+// storeBit appends a single bit.
+func (b *cellBuilder) storeBit(bit bool) {
+	if b.bitLen%8 == 0 {
+		b.data = append(b.data, 0)
+	}
+	if bit {
+		b.data[len(b.data)-1] |= 0x80 >> (b.bitLen % 8)
+	}
+	b.bitLen++
+}
+
+// storeUint appends the low n bits of v, most significant first.
+func (b *cellBuilder) storeUint(v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.storeBit(v>>i&1 == 1)
+	}
+}
+
+// storeBytes appends whole bytes.
+func (b *cellBuilder) storeBytes(p []byte) {
+	if b.bitLen%8 == 0 {
+		b.data = append(b.data, p...)
+		b.bitLen += len(p) * 8
+		return
+	}
+	for _, c := range p {
+		b.storeUint(uint64(c), 8)
+	}
+}
+
+// cellRef is the hash and depth of a child cell.
+type cellRef struct {
+	hash  []byte
+	depth uint16
+}
+
+// hash returns the representation hash of the cell:
+// SHA-256(d1 || d2 || padded data || child depths || child hashes).
+func (b *cellBuilder) hash(refs ...cellRef) []byte {
+	d1 := byte(len(refs))
+	d2 := byte((b.bitLen+7)/8 + b.bitLen/8)
+
+	data := append([]byte{}, b.data...)
+	if b.bitLen%8 != 0 {
+		// Completion tag: a single 1 bit after the data, then zero padding
+		data[len(data)-1] |= 0x80 >> (b.bitLen % 8)
+	}
+
+	h := sha256.New()
+	h.Write([]byte{d1, d2})
+	h.Write(data)
+	for _, ref := range refs {
+		var depth [2]byte
+		binary.BigEndian.PutUint16(depth[:], ref.depth)
+		h.Write(depth[:])
+	}
+	for _, ref := range refs {
+		h.Write(ref.hash)
+	}
+	return h.Sum(nil)
+}
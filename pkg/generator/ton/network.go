@@ -0,0 +1,130 @@
+package ton
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.TON,
+		Name:         "TON",
+		Title:        "TON",
+		Icon:         "💎",
+		Description:  "Base64url, UQ/EQ prefix",
+		ResultLabel:  "TON WALLET ADDRESS",
+		KeyType:      generator.KeyEd25519,
+		AddressTypes: []generator.AddressType{generator.AddressTypeWalletV4R2, generator.AddressTypeWalletV5R1},
+		Rules: func(config *generator.Config) generator.PatternRules {
+			// The workchain byte leaves only 4 possible values for the first character
+			workchain, bounceable, _ := parseOptions(config) // Validated with the options
+			return generator.PatternRules{
+				Alphabet:    generator.Base64URL,
+				Fixed:       AddressPrefix(workchain, bounceable),
+				PrefixChars: []string{NextCharRange(workchain)},
+			}
+		},
+		NewWorker: newWorker,
+		Options: []generator.Option{
+			{
+				Name:        OptionWorkchain,
+				Description: "workchain ID (0 = basechain, -1 = masterchain; default 0)",
+				Validate: func(value string) error {
+					_, err := ParseWorkchain(value)
+					return err
+				},
+			},
+			{
+				Name:        OptionBounceable,
+				Description: "match the bounceable (EQ...) form instead of non-bounceable (UQ...); default false",
+				Validate: func(value string) error {
+					_, err := strconv.ParseBool(value)
+					return err
+				},
+			},
+		},
+	})
+}
+
+// Config.Options keys of the workchain and the address form.
+const (
+	OptionWorkchain  = "workchain"
+	OptionBounceable = "bounceable"
+)
+
+// ParseWorkchain parses a workchain option value.
+func ParseWorkchain(value string) (int8, error) {
+	switch value {
+	case "0":
+		return BaseWorkchain, nil
+	case "-1":
+		return MasterWorkchain, nil
+	default:
+		return 0, fmt.Errorf("%q is not a workchain (0 or -1)", value)
+	}
+}
+
+// parseOptions returns the workchain and address form of the configuration.
+func parseOptions(config *generator.Config) (workchain int8, bounceable bool, err error) {
+	if value := config.Option(OptionWorkchain); value != "" {
+		if workchain, err = ParseWorkchain(value); err != nil {
+			return 0, false, err
+		}
+	}
+	if value := config.Option(OptionBounceable); value != "" {
+		if bounceable, err = strconv.ParseBool(value); err != nil {
+			return 0, false, err
+		}
+	}
+	return workchain, bounceable, nil
+}
+
+// newWorker returns a CPU search step for TON wallet addresses (Ed25519 + StateInit cell hash + Base64url)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine wallet version (default to v4R2)
+	version := WalletV4R2
+	if config.AddressType == generator.AddressTypeWalletV5R1 {
+		version = WalletV5R1
+	}
+	workchain, bounceable, err := parseOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		// Account ID = hash(StateInit{wallet code, data with pubkey})
+		hash := StateInitHash(pubKey, version, workchain)
+		address := EncodeAddress(workchain, hash, bounceable)
+
+		partial, ok := pattern.Check(address[FixedLen:])
+		if !ok {
+			return 1, nil
+		}
+		otherLabel := "Bounceable Address"
+		if bounceable {
+			otherLabel = "Non-Bounceable Address"
+		}
+		return 1, &generator.Result{
+			Network:    generator.TON,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Secret Key (hex, seed || pubkey)", Value: hex.EncodeToString(privKey)},
+				{Label: "Public Key (hex)", Value: hex.EncodeToString(pubKey)},
+				{Label: otherLabel, Value: EncodeAddress(workchain, hash, !bounceable)},
+				{Label: "Raw Address (wallet " + version.String() + ")", Value: RawAddress(workchain, hash)},
+			},
+		}
+	}, nil
+}
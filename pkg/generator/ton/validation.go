@@ -0,0 +1,11 @@
+package ton
+
+import "github.com/Amr-9/HexHunter/pkg/generator"
+
+// NextCharRange lists the characters possible right after the fixed prefix.
+// The third character holds the low 4 bits of the workchain byte and only 2 bits of the hash
+// (A-D for the basechain, 8 9 - _ for the masterchain).
+func NextCharRange(workchain int8) string {
+	base := int(uint8(workchain)&0x0F) << 2
+	return generator.Base64URL.Chars[base : base+4]
+}
@@ -0,0 +1,85 @@
+package ton
+
+import (
+	"encoding/hex"
+)
+
+// WalletVersion selects the wallet contract whose address is derived.
+type WalletVersion int
+
+const (
+	WalletV4R2 WalletVersion = iota // Wallet v4 revision 2
+	WalletV5R1                      // Wallet v5 revision 1 (W5)
+)
+
+// String returns the wallet version name.
+func (v WalletVersion) String() string {
+	if v == WalletV5R1 {
+		return "v5R1"
+	}
+	return "v4R2"
+}
+
+const (
+	// DefaultSubwalletID is the v4 subwallet ID for the basechain; wallets add the workchain to it.
+	DefaultSubwalletID = 698983191
+	// MainnetGlobalID is the TON mainnet global ID mixed into the v5 wallet ID.
+	MainnetGlobalID int32 = -239
+)
+
+// Code cell hashes and depths of the standard wallet contracts.
+// Only these are needed to hash the StateInit, not the code itself.
+var (
+	codeV4R2 = cellRef{
+		hash:  mustDecodeHex("feb5ff6820e2ff0d9483e7e0d62c817d846789fb4ae580c878866d959dabd5c0"),
+		depth: 7,
+	}
+	codeV5R1 = cellRef{
+		hash:  mustDecodeHex("20834b7b72b112147e1b2fb457b84e74d1a30f04f737d4f62a668e9552d2b72f"),
+		depth: 6,
+	}
+)
+
+// StateInitHash returns the account ID of a wallet: the hash of its StateInit cell
+// (code and data refs) for the given public key, version and workchain.
+func StateInitHash(pubKey []byte, version WalletVersion, workchain int8) []byte {
+	code := codeV4R2
+	data := &cellBuilder{}
+
+	switch version {
+	case WalletV5R1:
+		code = codeV5R1
+		data.storeBit(true)                               // is_signature_allowed
+		data.storeUint(0, 32)                             // seqno
+		data.storeUint(uint64(v5WalletID(workchain)), 32) // wallet_id
+		data.storeBytes(pubKey)                           // public_key
+		data.storeBit(false)                              // empty extensions dict
+	default:
+		subwalletID := uint32(DefaultSubwalletID + int32(workchain))
+		data.storeUint(0, 32)                   // seqno
+		data.storeUint(uint64(subwalletID), 32) // subwallet_id
+		data.storeBytes(pubKey)                 // public_key
+		data.storeBit(false)                    // empty plugins dict
+	}
+
+	// StateInit: no split_depth, no special, code and data present, no library
+	stateInit := &cellBuilder{}
+	stateInit.storeUint(0b00110, 5)
+	return stateInit.hash(code, cellRef{hash: data.hash(), depth: 0})
+}
+
+// v5WalletID returns the v5 wallet ID for subwallet 0 on mainnet:
+// client context (1 bit) | workchain (8) | version (8) | subwallet (15), XOR the global ID.
+func v5WalletID(workchain int8) uint32 {
+	globalID := MainnetGlobalID
+	context := uint32(1)<<31 | uint32(uint8(workchain))<<23
+	return context ^ uint32(globalID)
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,85 @@
+// Package tor provides Tor v3 onion service address vanity generation support.
+// Address = Base32-lower(pubkey || checksum || version) + ".onion", where
+// checksum = SHA3-256(".onion checksum" || pubkey || version)[:2] and version = 0x03.
+package tor
+
+import (
+	"crypto/sha512"
+	"encoding/base32"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// Version is the onion address version byte for v3 services.
+	Version byte = 0x03
+	// AddressSuffix is the top-level domain appended to every onion address.
+	AddressSuffix = ".onion"
+	// AddressLen is the length of the Base32 part of a v3 address (35 bytes, no padding).
+	AddressLen = 56
+
+	checksumPrefix = ".onion checksum"
+	checksumLen    = 2
+)
+
+// Key file names in a Tor HiddenServiceDir.
+const (
+	SecretKeyFile = "hs_ed25519_secret_key"
+	PublicKeyFile = "hs_ed25519_public_key"
+	HostnameFile  = "hostname"
+)
+
+// Key file headers, each padded with NUL bytes to 32 bytes.
+const (
+	secretKeyHeader = "== ed25519v1-secret: type0 ==\x00\x00\x00"
+	publicKeyHeader = "== ed25519v1-public: type0 ==\x00\x00\x00"
+)
+
+// base32Lower is RFC 4648 Base32 with a lowercase alphabet and no padding, as used by Tor.
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// EncodeAddress returns the 56-character Base32 part of the onion address (without ".onion").
+// Patterns are matched on this form.
+func EncodeAddress(pubKey []byte) string {
+	h := sha3.New256()
+	h.Write([]byte(checksumPrefix))
+	h.Write(pubKey)
+	h.Write([]byte{Version})
+	checksum := h.Sum(nil)[:checksumLen]
+
+	data := make([]byte, 0, len(pubKey)+checksumLen+1)
+	data = append(data, pubKey...)
+	data = append(data, checksum...)
+	data = append(data, Version)
+	return base32Lower.EncodeToString(data)
+}
+
+// DeriveAddress returns the full onion hostname for an Ed25519 public key.
+func DeriveAddress(pubKey []byte) string {
+	return EncodeAddress(pubKey) + AddressSuffix
+}
+
+// ExpandSecretKey returns Tor's 64-byte expanded secret key for an Ed25519 seed:
+// the clamped scalar SHA-512(seed)[:32] followed by the nonce prefix SHA-512(seed)[32:].
+func ExpandSecretKey(seed []byte) []byte {
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	return h[:]
+}
+
+// SecretKeyFileData returns the contents of hs_ed25519_secret_key.
+func SecretKeyFileData(seed []byte) []byte {
+	return append([]byte(secretKeyHeader), ExpandSecretKey(seed)...)
+}
+
+// PublicKeyFileData returns the contents of hs_ed25519_public_key.
+func PublicKeyFileData(pubKey []byte) []byte {
+	return append([]byte(publicKeyHeader), pubKey...)
+}
+
+// HostnameFileData returns the contents of the hostname file.
+func HostnameFileData(pubKey []byte) []byte {
+	return []byte(DeriveAddress(pubKey) + "\n")
+}
@@ -0,0 +1,66 @@
+package tor
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Tor,
+		Name:        "Tor",
+		Title:       "Tor onion service (v3)",
+		Icon:        "🧅",
+		Description: "Base32, .onion",
+		ResultLabel: "ONION SERVICE ADDRESS",
+		KeyType:     generator.KeyEd25519,
+		Rules: func(*generator.Config) generator.PatternRules {
+			// ".onion" is not part of the pattern; the trailing version byte fixes the last characters
+			return generator.PatternRules{
+				Alphabet: generator.Base32Lower,
+				Normalize: func(s string) string {
+					return strings.TrimSuffix(s, AddressSuffix)
+				},
+				SuffixChars: []string{LastChar, PenultimateCharRange},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for Tor v3 onion addresses (Ed25519 + SHA3-256 checksum + Base32)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return 0, nil
+		}
+
+		address := EncodeAddress(pubKey)
+
+		partial, ok := pattern.Check(address)
+		if !ok {
+			return 1, nil
+		}
+		// Files form a ready-to-use HiddenServiceDir
+		return 1, &generator.Result{
+			Network:    generator.Tor,
+			Partial:    partial,
+			Address:    address + AddressSuffix,
+			PrivateKey: hex.EncodeToString(privKey.Seed()),
+			Exports: []generator.KeyExport{
+				{Label: "Expanded Secret Key (hex)", Value: hex.EncodeToString(ExpandSecretKey(privKey.Seed()))},
+				{Label: "Public Key (hex)", Value: hex.EncodeToString(pubKey)},
+			},
+			Files: []generator.KeyFile{
+				{Name: SecretKeyFile, Data: SecretKeyFileData(privKey.Seed())},
+				{Name: PublicKeyFile, Data: PublicKeyFileData(pubKey)},
+				{Name: HostnameFile, Data: HostnameFileData(pubKey)},
+			},
+		}
+	}, nil
+}
@@ -0,0 +1,8 @@
+package tor
+
+// The address ends with the 0x03 version byte, so the last character is always 'd'
+// and the one before it carries only 2 checksum bits (plus 3 bits of the version byte).
+const (
+	LastChar             = "d"
+	PenultimateCharRange = "aiqy"
+)
@@ -3,16 +3,16 @@ package tron
 import (
 	"strings"
 
+	"github.com/Amr-9/HexHunter/pkg/generator"
 	"github.com/mr-tron/base58"
 )
 
 // Tron address constants
 const (
-	base58MinChar  = '1' // Smallest Base58 character (value 0)
-	base58MaxChar  = 'z' // Largest Base58 character (value 57)
-	tronAddrLen    = 34  // Tron addresses are 34 characters (T + 33 chars)
-	tronDataLen    = 25  // 21 bytes data + 4 bytes checksum = 25 bytes
-	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base58MinChar = '1' // Smallest Base58 character (value 0)
+	base58MaxChar = 'z' // Largest Base58 character (value 57)
+	tronAddrLen   = 34  // Tron addresses are 34 characters (T + 33 chars)
+	tronDataLen   = 25  // 21 bytes data + 4 bytes checksum = 25 bytes
 )
 
 // Base58Range represents the byte range for a Base58 prefix/suffix
@@ -41,7 +41,7 @@ func CalculateTronPrefixRange(prefix string) *Base58Range {
 
 	// Validate prefix contains only valid Base58 characters
 	for _, c := range prefix {
-		if !strings.ContainsRune(base58Alphabet, c) {
+		if !strings.ContainsRune(generator.Base58.Chars, c) {
 			return &Base58Range{Valid: false}
 		}
 	}
@@ -109,7 +109,7 @@ func CalculateTronSuffixRange(suffix string) *Base58Range {
 
 	// Validate suffix
 	for _, c := range suffix {
-		if !strings.ContainsRune(base58Alphabet, c) {
+		if !strings.ContainsRune(generator.Base58.Chars, c) {
 			return &Base58Range{Valid: false}
 		}
 	}
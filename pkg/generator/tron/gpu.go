@@ -26,11 +26,8 @@ import (
 	_ "embed"
 	"encoding/hex"
 	"fmt"
-	"log"
 	"math/big"
 	"os"
-	"sync/atomic"
-	"time"
 	"unsafe"
 
 	"github.com/Amr-9/HexHunter/pkg/generator"
@@ -72,13 +69,10 @@ type TronGPUGenerator struct {
 	curve *secp256k1.BitCurve
 
 	// stats
-	attempts  uint64
-	startTime time.Time
+	lifecycle generator.Lifecycle
 
 	// Pattern config
-	prefix   string
-	suffix   string
-	contains string
+	pattern *generator.Pattern
 }
 
 // NewTronGPUGenerator creates a new GPU-based generator for Tron.
@@ -87,6 +81,7 @@ func NewTronGPUGenerator() (*TronGPUGenerator, error) {
 		curve: secp256k1.S256(),
 	}
 	if err := g.initOpenCL(); err != nil {
+		g.release()
 		return nil, fmt.Errorf("failed to initialize OpenCL: %w", err)
 	}
 	return g, nil
@@ -97,37 +92,52 @@ func (g *TronGPUGenerator) Name() string {
 }
 
 func (g *TronGPUGenerator) Stats() generator.Stats {
-	attempts := atomic.LoadUint64(&g.attempts)
-	elapsed := time.Since(g.startTime).Seconds()
-	var hashRate float64
-	if elapsed > 0 {
-		hashRate = float64(attempts) / elapsed
-	}
-	return generator.Stats{
-		Attempts:    attempts,
-		HashRate:    hashRate,
-		ElapsedSecs: elapsed,
+	return g.lifecycle.Stats()
+}
+
+// UpdatePattern replaces the pattern of the running search. The GPU loop rewrites
+// the pattern buffers before its next batch.
+func (g *TronGPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return g.lifecycle.UpdatePattern(prefix, suffix, contains)
+}
+
+// Wait blocks until the GPU loop of the last search has exited.
+func (g *TronGPUGenerator) Wait() {
+	g.lifecycle.Wait()
+}
+
+// Close stops the running search, waits for it and releases the OpenCL context, queue and kernel.
+func (g *TronGPUGenerator) Close() error {
+	if g.lifecycle.Close() {
+		g.release()
 	}
+	return nil
 }
 
-func (g *TronGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
-	resultChan := make(chan generator.Result, 1)
-	g.startTime = time.Now()
-	atomic.StoreUint64(&g.attempts, 0)
+func (g *TronGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
+	pattern, err := generator.CompilePattern(config)
+	if err != nil {
+		return nil, err
+	}
 
-	g.prefix = config.Prefix
-	g.suffix = config.Suffix
-	g.contains = config.Contains
+	// Begin waits for the previous search, which still uses the buffers
+	ctx, err = g.lifecycle.Begin(ctx, config, pattern)
+	if err != nil {
+		return nil, err
+	}
 
-	go g.runGPU(ctx, resultChan, config)
-	return resultChan, nil
+	stream := generator.NewStream(ctx, config, g.Stats)
+	go func() {
+		err := g.runGPU(ctx, stream, config)
+		stream.Finish(g.lifecycle.End(), err)
+	}()
+	return stream.Events(), nil
 }
 
-func (g *TronGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generator.Result, config *generator.Config) {
+func (g *TronGPUGenerator) runGPU(ctx context.Context, stream *generator.Stream, config *generator.Config) error {
 	// Create buffers
 	if err := g.createBuffers(); err != nil {
-		log.Printf("GPU Buffer Error: %v", err)
-		return
+		return fmt.Errorf("GPU buffer error: %w", err)
 	}
 	defer g.releaseBuffers()
 
@@ -147,14 +157,20 @@ func (g *TronGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generat
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		default:
+			// Apply a pattern replaced by UpdatePattern before the next batch
+			if pattern := g.lifecycle.Pattern(); pattern != g.pattern {
+				if err := g.writePattern(pattern); err != nil {
+					return fmt.Errorf("pattern update failed: %w", err)
+				}
+			}
+
 			// 1. Reset found_flag
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufFlag, C.CL_TRUE, 0, 4,
 				unsafe.Pointer(&zero), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to reset flag: %d", ret)
-				return
+				return fmt.Errorf("failed to reset flag: %d", ret)
 			}
 
 			// 2. Compute BasePoint = base * G on CPU (in Jacobian form)
@@ -164,8 +180,7 @@ func (g *TronGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generat
 			ret = C.clEnqueueWriteBuffer(g.queue, g.bufBasePoint, C.CL_TRUE, 0, 96,
 				unsafe.Pointer(&basePointBytes[0]), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Failed to write base point: %d", ret)
-				return
+				return fmt.Errorf("failed to write base point: %d", ret)
 			}
 
 			// 4. Run Kernel (computes addresses, does Base58, matches pattern)
@@ -173,25 +188,25 @@ func (g *TronGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generat
 			localSize := C.size_t(localWorkSize)
 			ret = C.clEnqueueNDRangeKernel(g.queue, g.kernel, 1, nil, &globalSize, &localSize, 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Kernel execution failed: %d", ret)
-				return
+				return fmt.Errorf("kernel execution failed: %d", ret)
 			}
 
 			// 5. Read found flag
 			ret = C.clEnqueueReadBuffer(g.queue, g.bufFlag, C.CL_TRUE, 0, 4,
 				unsafe.Pointer(&foundFlag), 0, nil, nil)
 			if ret != C.CL_SUCCESS {
-				log.Printf("Read flag failed: %d", ret)
-				return
+				return fmt.Errorf("read flag failed: %d", ret)
 			}
 
-			// 6. If found, read result
+			// 6. Update stats, before a match so the final stats include this batch
+			g.lifecycle.Add(uint64(globalWorkSize))
+
+			// 7. If found, read result
 			if foundFlag != 0 {
 				ret = C.clEnqueueReadBuffer(g.queue, g.bufOutput, C.CL_TRUE, 0, C.size_t(outputBufferSize),
 					unsafe.Pointer(&hostOutput[0]), 0, nil, nil)
 				if ret != C.CL_SUCCESS {
-					log.Printf("Read output failed: %d", ret)
-					return
+					return fmt.Errorf("read output failed: %d", ret)
 				}
 
 				// Parse GID from output (big-endian)
@@ -209,16 +224,15 @@ func (g *TronGPUGenerator) runGPU(ctx context.Context, resultChan chan<- generat
 				privKey := new(big.Int).Add(baseInt, big.NewInt(int64(foundGid)))
 				privBytes := pad32(privKey.Bytes())
 
-				resultChan <- generator.Result{
+				stream.Match(generator.Result{
 					Address:    foundAddress,
 					PrivateKey: hex.EncodeToString(privBytes),
 					Network:    generator.Tron,
-				}
-				return
+				})
+				return nil
 			}
 
-			// 7. Advance stats and base key
-			atomic.AddUint64(&g.attempts, uint64(globalWorkSize))
+			// 8. Advance base key
 			baseInt.Add(baseInt, batchSizeInt)
 		}
 	}
@@ -332,38 +346,25 @@ func (g *TronGPUGenerator) createBuffers() error {
 		return fmt.Errorf("bufFlag failed: %d", ret)
 	}
 
-	// 5. Prefix pattern (pad to 44 bytes max)
-	prefixData := make([]byte, 44)
-	copy(prefixData, g.prefix)
-	g.bufPrefix = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		44, unsafe.Pointer(&prefixData[0]), &ret)
+	// 5. Prefix pattern (44 bytes max)
+	g.bufPrefix = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 44, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufPrefix failed: %d", ret)
 	}
 
 	// 6. Suffix pattern
-	suffixData := make([]byte, 44)
-	copy(suffixData, g.suffix)
-	g.bufSuffix = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		44, unsafe.Pointer(&suffixData[0]), &ret)
+	g.bufSuffix = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 44, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufSuffix failed: %d", ret)
 	}
 
 	// 7. Contains pattern
-	containsData := make([]byte, 44)
-	copy(containsData, g.contains)
-	g.bufContains = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY|C.CL_MEM_COPY_HOST_PTR,
-		44, unsafe.Pointer(&containsData[0]), &ret)
+	g.bufContains = C.clCreateBuffer(g.context, C.CL_MEM_READ_ONLY, 44, nil, &ret)
 	if ret != C.CL_SUCCESS {
 		return fmt.Errorf("bufContains failed: %d", ret)
 	}
 
-	// Set Kernel Args
-	prefixLen := C.uint(len(g.prefix))
-	suffixLen := C.uint(len(g.suffix))
-	containsLen := C.uint(len(g.contains))
-
+	// Set Kernel Args (the pattern lengths are set with the pattern)
 	C.clSetKernelArg(g.kernel, 0, C.size_t(unsafe.Sizeof(g.bufBasePoint)), unsafe.Pointer(&g.bufBasePoint))
 	C.clSetKernelArg(g.kernel, 1, C.size_t(unsafe.Sizeof(g.bufTable)), unsafe.Pointer(&g.bufTable))
 	C.clSetKernelArg(g.kernel, 2, C.size_t(unsafe.Sizeof(g.bufOutput)), unsafe.Pointer(&g.bufOutput))
@@ -371,10 +372,46 @@ func (g *TronGPUGenerator) createBuffers() error {
 	C.clSetKernelArg(g.kernel, 4, C.size_t(unsafe.Sizeof(g.bufPrefix)), unsafe.Pointer(&g.bufPrefix))
 	C.clSetKernelArg(g.kernel, 5, C.size_t(unsafe.Sizeof(g.bufSuffix)), unsafe.Pointer(&g.bufSuffix))
 	C.clSetKernelArg(g.kernel, 6, C.size_t(unsafe.Sizeof(g.bufContains)), unsafe.Pointer(&g.bufContains))
+
+	return g.writePattern(g.lifecycle.Pattern())
+}
+
+// writePattern uploads the prefix/suffix/contains patterns and lengths to the GPU.
+// It runs when the buffers are created and between batches after UpdatePattern.
+func (g *TronGPUGenerator) writePattern(pattern *generator.Pattern) error {
+	gpu := pattern.GPU()
+
+	targets := []struct {
+		name string
+		buf  C.cl_mem
+		data []byte
+	}{
+		{"prefix", g.bufPrefix, gpu.Prefix},
+		{"suffix", g.bufSuffix, gpu.Suffix},
+		{"contains", g.bufContains, gpu.Contains},
+	}
+	for _, target := range targets {
+		if len(target.data) > 44 {
+			return fmt.Errorf("%s is longer than an address", target.name)
+		}
+		// Zero-padded to the buffer size, so a shorter pattern leaves no stale bytes
+		data := make([]byte, 44)
+		copy(data, target.data)
+		ret := C.clEnqueueWriteBuffer(g.queue, target.buf, C.CL_TRUE, 0, 44,
+			unsafe.Pointer(&data[0]), 0, nil, nil)
+		if ret != C.CL_SUCCESS {
+			return fmt.Errorf("failed to write %s: %d", target.name, ret)
+		}
+	}
+
+	prefixLen := C.uint(len(gpu.Prefix))
+	suffixLen := C.uint(len(gpu.Suffix))
+	containsLen := C.uint(len(gpu.Contains))
 	C.clSetKernelArg(g.kernel, 7, C.size_t(unsafe.Sizeof(prefixLen)), unsafe.Pointer(&prefixLen))
 	C.clSetKernelArg(g.kernel, 8, C.size_t(unsafe.Sizeof(suffixLen)), unsafe.Pointer(&suffixLen))
 	C.clSetKernelArg(g.kernel, 9, C.size_t(unsafe.Sizeof(containsLen)), unsafe.Pointer(&containsLen))
 
+	g.pattern = pattern
 	return nil
 }
 
@@ -442,18 +479,22 @@ func (g *TronGPUGenerator) releaseBuffers() {
 	}
 }
 
-func (g *TronGPUGenerator) Release() {
+func (g *TronGPUGenerator) release() {
 	if g.kernel != nil {
 		C.clReleaseKernel(g.kernel)
+		g.kernel = nil
 	}
 	if g.program != nil {
 		C.clReleaseProgram(g.program)
+		g.program = nil
 	}
 	if g.queue != nil {
 		C.clReleaseCommandQueue(g.queue)
+		g.queue = nil
 	}
 	if g.context != nil {
 		C.clReleaseContext(g.context)
+		g.context = nil
 	}
 }
 
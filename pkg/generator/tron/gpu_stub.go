@@ -30,9 +30,19 @@ func (g *TronGPUGenerator) Stats() generator.Stats {
 }
 
 // Start returns an error as GPU is not available.
-func (g *TronGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Result, error) {
+func (g *TronGPUGenerator) Start(ctx context.Context, config *generator.Config) (<-chan generator.Event, error) {
 	return nil, fmt.Errorf("GPU support not compiled")
 }
 
-// Release does nothing.
-func (g *TronGPUGenerator) Release() {}
+// UpdatePattern returns an error as GPU is not available.
+func (g *TronGPUGenerator) UpdatePattern(prefix, suffix, contains string) error {
+	return fmt.Errorf("GPU support not compiled")
+}
+
+// Wait does nothing.
+func (g *TronGPUGenerator) Wait() {}
+
+// Close does nothing.
+func (g *TronGPUGenerator) Close() error {
+	return nil
+}
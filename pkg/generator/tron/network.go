@@ -0,0 +1,75 @@
+package tron
+
+import (
+	"fmt"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:     generator.Tron,
+		Name:        "Tron",
+		Title:       "Tron (TRX)",
+		Icon:        "₮",
+		Description: "Base58, T prefix",
+		ResultLabel: "TRON ADDRESS",
+		KeyType:     generator.KeySecp256k1,
+		Rules: func(*generator.Config) generator.PatternRules {
+			return generator.PatternRules{
+				Alphabet:    generator.Base58,
+				Fixed:       "T",
+				CheckPrefix: checkPrefix,
+			}
+		},
+		NewWorker: newWorker,
+		GPU: func() (generator.Generator, error) {
+			gen, err := NewTronGPUGenerator()
+			if err != nil {
+				return nil, err
+			}
+			return gen, nil
+		},
+	})
+}
+
+// checkPrefix rejects prefixes that cannot follow the 'T': the version byte 0x41
+// makes the second character an uppercase letter.
+func checkPrefix(prefix string) error {
+	if c := prefix[0]; (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') {
+		return &generator.PatternError{
+			Msg:  fmt.Sprintf("first character after 'T' must be an uppercase letter (A-Z), not '%c'", c),
+			Hint: "Digits and lowercase letters are not possible at this position in Tron addresses",
+		}
+	}
+	return nil
+}
+
+// newWorker returns a CPU search step for Tron addresses (secp256k1 + Keccak-256 + Base58Check)
+func newWorker(_ *generator.Config) (generator.Worker, error) {
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		// Generate secp256k1 key pair (same as Ethereum)
+		privateKey, err := crypto.GenerateKey()
+		if err != nil {
+			return 0, nil
+		}
+
+		// Get uncompressed public key bytes (65 bytes: 0x04 + x + y)
+		pubKeyBytes := crypto.FromECDSAPub(&privateKey.PublicKey)
+
+		// Derive Tron address
+		address := DeriveAddress(pubKeyBytes)
+
+		partial, ok := pattern.Check(address[1:])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.Tron,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: PrivateKeyToHex(crypto.FromECDSA(privateKey)),
+		}
+	}, nil
+}
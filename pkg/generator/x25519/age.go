@@ -0,0 +1,52 @@
+package x25519
+
+import (
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+const (
+	// AgeRecipientHRP is the Bech32 human-readable part for age recipients.
+	AgeRecipientHRP = "age"
+	// AgeIdentityHRP is the Bech32 human-readable part for age identities (written uppercase).
+	AgeIdentityHRP = "age-secret-key-"
+	// AgePrefix is the fixed leading part of every age recipient (HRP + separator).
+	AgePrefix = AgeRecipientHRP + "1"
+	// AgeIdentityFile is the file name written next to the result, as in `age-keygen -o key.txt`.
+	AgeIdentityFile = "key.txt"
+)
+
+// AgeRecipient returns the age recipient ("age1...") for a public key.
+func AgeRecipient(pubKey []byte) string {
+	return encodeBech32(AgeRecipientHRP, pubKey)
+}
+
+// AgeIdentity returns the age identity ("AGE-SECRET-KEY-1...") for a private key.
+func AgeIdentity(privKey []byte) string {
+	return strings.ToUpper(encodeBech32(AgeIdentityHRP, privKey))
+}
+
+// AgeIdentityFileData returns an identity file in age-keygen's format.
+func AgeIdentityFileData(privKey, pubKey []byte, created time.Time) []byte {
+	var b strings.Builder
+	b.WriteString("# created: " + created.Format(time.RFC3339) + "\n")
+	b.WriteString("# public key: " + AgeRecipient(pubKey) + "\n")
+	b.WriteString(AgeIdentity(privKey) + "\n")
+	return []byte(b.String())
+}
+
+// encodeBech32 encodes 32 bytes of key material under the given HRP.
+// age uses classic Bech32, not Bech32m.
+func encodeBech32(hrp string, data []byte) string {
+	conv, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return ""
+	}
+	encoded, err := bech32.Encode(hrp, conv)
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
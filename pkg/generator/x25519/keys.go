@@ -0,0 +1,30 @@
+// Package x25519 provides X25519 (Curve25519 Diffie-Hellman) key vanity generation support
+// for WireGuard public keys (standard Base64) and age recipients (Bech32 "age1...").
+package x25519
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+)
+
+// KeySize is the size of X25519 private and public keys in bytes.
+const KeySize = 32
+
+// GenerateKey returns a random X25519 private key and its public key.
+func GenerateKey() (privKey, pubKey []byte, err error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key.Bytes(), key.PublicKey().Bytes(), nil
+}
+
+// Clamp returns the private key with the RFC 7748 bits set, as `wg genkey` outputs it.
+// Clamping does not change the public key, since X25519 clamps internally.
+func Clamp(privKey []byte) []byte {
+	clamped := append([]byte{}, privKey...)
+	clamped[0] &= 248
+	clamped[31] &= 127
+	clamped[31] |= 64
+	return clamped
+}
@@ -0,0 +1,106 @@
+package x25519
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.X25519,
+		Name:         "X25519",
+		Title:        "X25519 key",
+		Icon:         "🛡",
+		Description:  "WireGuard Base64 or age1 recipient",
+		ResultLabel:  "X25519 PUBLIC KEY",
+		KeyType:      generator.KeyX25519,
+		AddressTypes: []generator.AddressType{generator.AddressTypeWireGuard, generator.AddressTypeAge},
+		Rules: func(config *generator.Config) generator.PatternRules {
+			if config.AddressType == generator.AddressTypeAge {
+				return generator.PatternRules{
+					Alphabet: generator.Bech32,
+					Fixed:    AgePrefix,
+					CheckPrefix: func(prefix string) error {
+						return checkPrefix(prefix, true)
+					},
+					// The last 6 characters are the checksum; before them the key padding limits the characters
+					CheckSuffix: func(suffix string) error {
+						return checkSuffix(suffix, true)
+					},
+				}
+			}
+			// Padding and the key's top bit fix the last characters; '=' is not part of the pattern
+			return generator.PatternRules{
+				Alphabet: generator.Base64,
+				Normalize: func(s string) string {
+					return strings.TrimRight(s, "=")
+				},
+				SuffixChars: []string{CharRange(42, false)},
+				CheckPrefix: func(prefix string) error {
+					return checkPrefix(prefix, false)
+				},
+				CheckSuffix: func(suffix string) error {
+					return checkSuffix(suffix, false)
+				},
+			}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for X25519 keys (WireGuard Base64 or age Bech32 recipient)
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine key format (default to WireGuard)
+	age := config.AddressType == generator.AddressTypeAge
+	// Patterns match after "age1"; WireGuard keys have no fixed start
+	skip := 0
+	if age {
+		skip = len(AgePrefix)
+	}
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		privKey, pubKey, err := GenerateKey()
+		if err != nil {
+			return 0, nil
+		}
+
+		var text string
+		if age {
+			text = AgeRecipient(pubKey)
+		} else {
+			text = WireGuardMatchText(pubKey)
+		}
+
+		partial, ok := pattern.Check(text[skip:])
+		if !ok {
+			return 1, nil
+		}
+		// The same key works for both formats, so write both key files
+		wgPrivKey := WireGuardKey(Clamp(privKey))
+		result := &generator.Result{
+			Network:    generator.X25519,
+			Partial:    partial,
+			Address:    WireGuardKey(pubKey),
+			PrivateKey: wgPrivKey,
+			Exports: []generator.KeyExport{
+				{Label: "age Recipient", Value: AgeRecipient(pubKey)},
+				{Label: "age Identity", Value: AgeIdentity(privKey)},
+			},
+			Files: []generator.KeyFile{
+				{Name: WireGuardConfigFile, Data: WireGuardConfig(privKey, pubKey)},
+				{Name: AgeIdentityFile, Data: AgeIdentityFileData(privKey, pubKey, time.Now())},
+			},
+		}
+		if age {
+			result.Address = text
+			result.PrivateKey = AgeIdentity(privKey)
+			result.Exports = []generator.KeyExport{
+				{Label: "WireGuard Public Key", Value: WireGuardKey(pubKey)},
+				{Label: "WireGuard [Interface]", Value: "PrivateKey = " + wgPrivKey},
+			}
+		}
+		return 1, result
+	}, nil
+}
@@ -0,0 +1,128 @@
+package x25519
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+// Layout of the matched text of each format.
+const (
+	keyBits        = KeySize * 8
+	wireGuardLen   = 43 // Base64 characters without the '=' padding
+	ageDataLen     = 52 // Bech32 characters carrying the key
+	ageChecksumLen = 6
+)
+
+// zeroBit is the top bit of the little-endian u-coordinate, always 0 since u < 2^255-19.
+// Counted from the start of the big-endian bit stream, it is the first bit of byte 31.
+const zeroBit = 31 * 8
+
+// CharRange returns the characters possible at a position of the matched text
+// (the WireGuard key without padding, or the age recipient after "age1").
+// It is empty past the end of the text.
+func CharRange(pos int, age bool) string {
+	if pos < 0 || pos >= matchLen(age) {
+		return ""
+	}
+	if age {
+		if pos >= ageDataLen {
+			return generator.Bech32.Chars // checksum
+		}
+		return keyCharRange(pos, 5, generator.Bech32.Chars)
+	}
+	return keyCharRange(pos, 6, generator.Base64.Chars)
+}
+
+// checkPrefix explains why a prefix cannot occur at the start of the matched text, or returns nil.
+func checkPrefix(prefix string, age bool) error {
+	if len(prefix) > matchLen(age) {
+		return tooLong(age)
+	}
+	i := misfit(prefix, 0, age)
+	if i < 0 {
+		return nil
+	}
+	position := "first character"
+	if i > 0 {
+		position = fmt.Sprintf("character %d", i+1)
+	}
+	if age {
+		position += fmt.Sprintf(" after '%s'", AgePrefix)
+	}
+	return notPossible(position, CharRange(i, age))
+}
+
+// checkSuffix explains why a suffix cannot occur at the end of the matched text, or returns nil.
+func checkSuffix(suffix string, age bool) error {
+	if len(suffix) > matchLen(age) {
+		return tooLong(age)
+	}
+	pos := matchLen(age) - len(suffix)
+	i := misfit(suffix, pos, age)
+	if i < 0 {
+		return nil
+	}
+	position := "last character"
+	if fromEnd := len(suffix) - i; fromEnd > 1 {
+		position = fmt.Sprintf("character %d from the end", fromEnd)
+	}
+	return notPossible(position, CharRange(pos+i, age))
+}
+
+// tooLong is the error for a pattern longer than the matched text.
+func tooLong(age bool) error {
+	if age {
+		return &generator.PatternError{Msg: fmt.Sprintf("longer than the %d characters of an age recipient after '%s'", matchLen(age), AgePrefix)}
+	}
+	return &generator.PatternError{Msg: fmt.Sprintf("longer than the %d characters of a WireGuard key", matchLen(age))}
+}
+
+// notPossible is the error for a character the key's fixed bits rule out at a position.
+func notPossible(position, allowed string) error {
+	return &generator.PatternError{
+		Msg:  fmt.Sprintf("%s must be one of: %s", position, allowed),
+		Hint: "Other characters are not possible at this position",
+	}
+}
+
+// matchLen returns the length of the matched text.
+func matchLen(age bool) int {
+	if age {
+		return ageDataLen + ageChecksumLen
+	}
+	return wireGuardLen
+}
+
+// keyCharRange returns the characters whose bits agree with the key's fixed bits:
+// padding after the last key bit and the always-zero top bit of the u-coordinate.
+func keyCharRange(pos, bitsPerChar int, alphabet string) string {
+	var allowed []byte
+	for v := 0; v < len(alphabet); v++ {
+		ok := true
+		for j := 0; j < bitsPerChar; j++ {
+			bit := pos*bitsPerChar + j
+			set := v>>(bitsPerChar-1-j)&1 == 1
+			if set && (bit >= keyBits || bit == zeroBit) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			allowed = append(allowed, alphabet[v])
+		}
+	}
+	return string(allowed)
+}
+
+// misfit returns the index in s of the first character outside the per-position
+// character ranges starting at pos, or -1 if s fits within the matched text there.
+func misfit(s string, pos int, age bool) int {
+	for i, c := range s {
+		if !strings.ContainsRune(CharRange(pos+i, age), c) {
+			return i
+		}
+	}
+	return -1
+}
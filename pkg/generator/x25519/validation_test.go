@@ -0,0 +1,70 @@
+package x25519
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		age     bool
+		wantErr string // Empty if the prefix is possible
+	}{
+		{name: "wireguard", prefix: "AbC+/", age: false},
+		{name: "wireguard full key", prefix: strings.Repeat("A", wireGuardLen), age: false},
+		{name: "wireguard too long", prefix: strings.Repeat("A", 60), age: false, wantErr: "longer than the 43 characters"},
+		// Character 42 holds the always-zero bit 248 and the last key bits
+		{name: "wireguard zero bit", prefix: strings.Repeat("A", 41) + "/", age: false, wantErr: "character 42 must be one of"},
+		{name: "age", prefix: "qpzry", age: true},
+		{name: "age key and checksum", prefix: strings.Repeat("q", ageDataLen+ageChecksumLen), age: true},
+		{name: "age too long", prefix: strings.Repeat("q", 70), age: true, wantErr: "longer than the 58 characters"},
+		// Character 50 holds the always-zero bit 248, not the end of the key
+		{name: "age zero bit", prefix: strings.Repeat("q", 49) + "l", age: true, wantErr: "character 50 after 'age1' must be one of"},
+		{name: "age padding", prefix: strings.Repeat("q", 51) + "p", age: true, wantErr: "character 52 after 'age1' must be one of: qs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPrefix(tt.prefix, tt.age)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("checkPrefix rejected a possible prefix: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("checkPrefix accepted an impossible prefix")
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("checkPrefix error %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckSuffix(t *testing.T) {
+	tests := []struct {
+		name    string
+		suffix  string
+		age     bool
+		wantErr string
+	}{
+		{name: "wireguard", suffix: "AE", age: false},
+		{name: "wireguard last character", suffix: "B", age: false, wantErr: "last character must be one of"},
+		{name: "wireguard too long", suffix: strings.Repeat("A", 44), age: false, wantErr: "longer than the 43 characters"},
+		{name: "age checksum", suffix: "lllll", age: true},
+		{name: "age padding", suffix: "plllll", age: true},
+		{name: "age key padding", suffix: "llllllll", age: true, wantErr: "character 7 from the end must be one of"},
+		{name: "age too long", suffix: strings.Repeat("q", 59), age: true, wantErr: "longer than the 58 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSuffix(tt.suffix, tt.age)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("checkSuffix rejected a possible suffix: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("checkSuffix accepted an impossible suffix")
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("checkSuffix error %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package x25519
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// WireGuardConfigFile is the file name written next to the result.
+const WireGuardConfigFile = "wg0.conf"
+
+// WireGuardKey returns a key in wg format (standard Base64, 44 chars ending in '=').
+func WireGuardKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// WireGuardMatchText returns the part of a wg-format key used for matching:
+// the 43 significant characters, without the '=' padding.
+func WireGuardMatchText(pubKey []byte) string {
+	return base64.RawStdEncoding.EncodeToString(pubKey)
+}
+
+// WireGuardConfig returns an [Interface] section with the private key, ready for wg-quick.
+func WireGuardConfig(privKey, pubKey []byte) []byte {
+	var b strings.Builder
+	b.WriteString("# PublicKey = " + WireGuardKey(pubKey) + "\n")
+	b.WriteString("[Interface]\n")
+	b.WriteString("PrivateKey = " + WireGuardKey(Clamp(privKey)) + "\n")
+	return []byte(b.String())
+}
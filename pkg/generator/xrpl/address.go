@@ -0,0 +1,55 @@
+// Package xrpl provides XRP Ledger classic address vanity generation support.
+// Classic addresses (r...) are Base58Check over HASH160 of the public key,
+// using the Ripple alphabet. Both secp256k1 and Ed25519 keys are supported.
+package xrpl
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/Amr-9/HexHunter/pkg/generator/bitcoin"
+)
+
+const (
+	// AccountIDVersion is the version byte of classic addresses (encodes as 'r').
+	AccountIDVersion byte = 0x00
+	// FamilySeedVersion is the version byte of secp256k1 family seeds (encodes as 's').
+	FamilySeedVersion byte = 0x21
+	// AddressPrefix is the fixed first character of every classic address.
+	AddressPrefix = "r"
+	// SeedSize is the size of the entropy behind a family seed.
+	SeedSize = 16
+)
+
+// ed25519SeedPrefix is the 3-byte version prefix of Ed25519 family seeds (encodes as "sEd").
+var ed25519SeedPrefix = []byte{0x01, 0xE1, 0x4B}
+
+// DeriveAddress derives a classic address from a 33-byte XRPL public key
+// (compressed secp256k1, or 0xED followed by the Ed25519 key).
+// Address = Base58Check(0x00 + HASH160(pubkey)) with the Ripple alphabet.
+func DeriveAddress(pubKey []byte) string {
+	data := make([]byte, 21)
+	data[0] = AccountIDVersion
+	copy(data[1:], bitcoin.Hash160(pubKey))
+
+	return bitcoin.Base58CheckEncodeAlphabet(data, bitcoin.RippleAlphabet)
+}
+
+// EncodeSeed encodes 16 bytes of seed entropy as a family seed (s...).
+// Ed25519 seeds use a distinct prefix so wallets know which key type to derive.
+func EncodeSeed(seed []byte, ed25519 bool) string {
+	var data []byte
+	if ed25519 {
+		data = append(data, ed25519SeedPrefix...)
+	} else {
+		data = append(data, FamilySeedVersion)
+	}
+	data = append(data, seed...)
+
+	return bitcoin.Base58CheckEncodeAlphabet(data, bitcoin.RippleAlphabet)
+}
+
+// KeyToHex returns key bytes as uppercase hex, the convention in XRPL tooling.
+func KeyToHex(key []byte) string {
+	return strings.ToUpper(hex.EncodeToString(key))
+}
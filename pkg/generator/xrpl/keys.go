@@ -0,0 +1,88 @@
+package xrpl
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// GenerateSeed generates 16 bytes of random family seed entropy.
+func GenerateSeed() ([]byte, error) {
+	seed := make([]byte, SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// DeriveSecp256k1 derives the account key pair from a family seed the way rippled does:
+//
+//	root   = first valid SHA512Half(seed || seq)
+//	tweak  = first valid SHA512Half(rootPub || accountIndex(0) || subSeq)
+//	account private key = (root + tweak) mod n
+//
+// Returns the 32-byte private key and the 33-byte compressed public key.
+func DeriveSecp256k1(seed []byte) ([]byte, []byte, error) {
+	root, err := deriveScalar(seed, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rootPoint btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(root, &rootPoint)
+	rootPoint.ToAffine()
+	rootPub := btcec.NewPublicKey(&rootPoint.X, &rootPoint.Y).SerializeCompressed()
+
+	// Account index 0, as used by every wallet for the master key
+	tweak, err := deriveScalar(rootPub, []byte{0, 0, 0, 0})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accountKey btcec.ModNScalar
+	accountKey.Add2(root, tweak)
+
+	privKey := btcec.PrivKeyFromScalar(&accountKey)
+	return privKey.Serialize(), privKey.PubKey().SerializeCompressed(), nil
+}
+
+// DeriveEd25519 derives the account key pair from an Ed25519 family seed.
+// The Ed25519 seed is SHA512Half(seed); the XRPL public key is 0xED || pubkey.
+// Returns the 64-byte Ed25519 private key and the 33-byte XRPL public key.
+func DeriveEd25519(seed []byte) (ed25519.PrivateKey, []byte) {
+	hash := sha512.Sum512(seed)
+	privKey := ed25519.NewKeyFromSeed(hash[:32])
+
+	pubKey := make([]byte, 0, 33)
+	pubKey = append(pubKey, 0xED)
+	pubKey = append(pubKey, privKey.Public().(ed25519.PublicKey)...)
+
+	return privKey, pubKey
+}
+
+// deriveScalar returns the first SHA512Half(data || extra || seq) that is a valid
+// secp256k1 private key, incrementing the big-endian 32-bit seq on rejection.
+func deriveScalar(data, extra []byte) (*btcec.ModNScalar, error) {
+	buf := make([]byte, 0, len(data)+len(extra)+4)
+	buf = append(buf, data...)
+	buf = append(buf, extra...)
+	buf = append(buf, 0, 0, 0, 0)
+	seqBytes := buf[len(buf)-4:]
+
+	for seq := uint32(0); ; seq++ {
+		binary.BigEndian.PutUint32(seqBytes, seq)
+		hash := sha512.Sum512(buf)
+
+		var scalar btcec.ModNScalar
+		if overflow := scalar.SetByteSlice(hash[:32]); !overflow && !scalar.IsZero() {
+			return &scalar, nil
+		}
+		if seq == ^uint32(0) {
+			return nil, fmt.Errorf("no valid secp256k1 key for seed")
+		}
+	}
+}
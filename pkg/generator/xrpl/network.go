@@ -0,0 +1,73 @@
+package xrpl
+
+import (
+	"crypto/ed25519"
+
+	"github.com/Amr-9/HexHunter/pkg/generator"
+)
+
+func init() {
+	generator.Register(generator.NetworkInfo{
+		Network:      generator.XRPL,
+		Name:         "XRPL",
+		Title:        "XRP Ledger (XRP)",
+		Icon:         "✕",
+		Description:  "Base58, r prefix",
+		ResultLabel:  "XRPL ADDRESS",
+		KeyType:      generator.KeySecp256k1,
+		AddressTypes: []generator.AddressType{generator.AddressTypeSecp256k1, generator.AddressTypeEd25519},
+		Rules: func(*generator.Config) generator.PatternRules {
+			// The Ripple alphabet orders the Base58 characters differently but uses the same set
+			return generator.PatternRules{Alphabet: generator.Base58, Fixed: "r"}
+		},
+		NewWorker: newWorker,
+	})
+}
+
+// newWorker returns a CPU search step for XRPL classic addresses (secp256k1 or Ed25519 + HASH160 + Base58Check).
+// It grinds 16-byte family seeds so the result imports into any wallet as an s... secret.
+func newWorker(config *generator.Config) (generator.Worker, error) {
+	// Determine key type (default to secp256k1)
+	isEd25519 := config.AddressType == generator.AddressTypeEd25519
+
+	return func(pattern *generator.Pattern) (uint64, *generator.Result) {
+		seed, err := GenerateSeed()
+		if err != nil {
+			return 0, nil
+		}
+
+		var privKeyHex string
+		var pubKey []byte
+		if isEd25519 {
+			var privKey ed25519.PrivateKey
+			privKey, pubKey = DeriveEd25519(seed)
+			// XRPL tooling prefixes Ed25519 private keys with ED as well
+			privKeyHex = "ED" + KeyToHex(privKey.Seed())
+		} else {
+			var privKey []byte
+			privKey, pubKey, err = DeriveSecp256k1(seed)
+			if err != nil {
+				return 0, nil
+			}
+			privKeyHex = KeyToHex(privKey)
+		}
+
+		// Classic address = Base58Check(0x00 + HASH160(pubkey)), Ripple alphabet
+		address := DeriveAddress(pubKey)
+
+		partial, ok := pattern.Check(address[len(AddressPrefix):])
+		if !ok {
+			return 1, nil
+		}
+		return 1, &generator.Result{
+			Network:    generator.XRPL,
+			Partial:    partial,
+			Address:    address,
+			PrivateKey: EncodeSeed(seed, isEd25519),
+			Exports: []generator.KeyExport{
+				{Label: "Private Key (hex)", Value: privKeyHex},
+				{Label: "Public Key (hex)", Value: KeyToHex(pubKey)},
+			},
+		}
+	}, nil
+}